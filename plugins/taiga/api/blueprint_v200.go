@@ -19,6 +19,8 @@ package api
 
 import (
 	"context"
+	"slices"
+	"time"
 
 	"github.com/apache/incubator-devlake/core/errors"
 	coreModels "github.com/apache/incubator-devlake/core/models"
@@ -29,11 +31,13 @@ import (
 	helper "github.com/apache/incubator-devlake/helpers/pluginhelper/api"
 	"github.com/apache/incubator-devlake/helpers/srvhelper"
 	"github.com/irfanuddinahmad/taiga-devlake-plugin/plugins/taiga/models"
+	"github.com/irfanuddinahmad/taiga-devlake-plugin/plugins/taiga/tasks"
 )
 
 type TaigaTaskOptions struct {
 	ConnectionId uint64 `json:"connectionId"`
 	ProjectId    uint64 `json:"projectId"`
+	TimeAfter    string `json:"timeAfter,omitempty"`
 }
 
 func MakeDataSourcePipelinePlanV200(
@@ -46,13 +50,20 @@ func MakeDataSourcePipelinePlanV200(
 	if err != nil {
 		return nil, nil, err
 	}
-	scopeDetails, err := dsHelper.ScopeSrv.MapScopeDetails(connectionId, bpScopes)
+
+	// needed for the connection to populate its access tokens
+	apiClient, err := helper.NewApiClientFromConnection(context.TODO(), basicRes, connection)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	// needed for the connection to populate its access tokens
-	_, err = helper.NewApiClientFromConnection(context.TODO(), basicRes, connection)
+	if connection.AutoDiscoverProjects {
+		bpScopes, err = discoverProjects(apiClient, connection, bpScopes)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	scopeDetails, err := dsHelper.ScopeSrv.MapScopeDetails(connectionId, bpScopes)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -74,33 +85,124 @@ func makeDataSourcePipelinePlanV200(
 	scopeDetails []*srvhelper.ScopeDetail[models.TaigaProject, models.TaigaScopeConfig],
 	connection *models.TaigaConnection,
 ) (coreModels.PipelinePlan, errors.Error) {
-	plan := make(coreModels.PipelinePlan, len(scopeDetails))
-	for i, scopeDetail := range scopeDetails {
-		stage := plan[i]
-		if stage == nil {
-			stage = coreModels.PipelineStage{}
-		}
-
+	projectTasks := make([]*coreModels.PipelineTask, 0, len(scopeDetails))
+	sharesCatalogs := false
+	for _, scopeDetail := range scopeDetails {
 		scope, scopeConfig := scopeDetail.Scope, scopeDetail.ScopeConfig
+		// discovered projects have no scope config unless the connection has a default one
+		if scopeConfig == nil {
+			scopeConfig = new(models.TaigaScopeConfig)
+		}
 		// construct task options for Taiga
+		options := TaigaTaskOptions{
+			ConnectionId: scope.ConnectionId,
+			ProjectId:    scope.ProjectId,
+		}
+		if scopeConfig.TimeAfter != nil {
+			options.TimeAfter = scopeConfig.TimeAfter.Format(time.RFC3339)
+		}
+		entities := withDefaultEntities(scopeConfig.Entities)
 		task, err := helper.MakePipelinePlanTask(
 			"taiga",
 			subtaskMetas,
-			scopeConfig.Entities,
-			TaigaTaskOptions{
-				ConnectionId: scope.ConnectionId,
-				ProjectId:    scope.ProjectId,
-			},
+			entities,
+			options,
 		)
 		if err != nil {
 			return nil, err
 		}
+		task.Subtasks = withDependencies(subtaskMetas, task.Subtasks)
+		if slices.Contains(entities, plugin.DOMAIN_TYPE_CROSS) {
+			sharesCatalogs = true
+		}
+		projectTasks = append(projectTasks, task)
+	}
+
+	// the users are the same for every project, collect them once for the connection
+	if sharesCatalogs {
+		catalogMetas := make([]plugin.SubTaskMeta, 0, len(catalogSubtasks))
+		for _, meta := range subtaskMetas {
+			if catalogSubtasks[meta.Name] {
+				catalogMetas = append(catalogMetas, meta)
+			}
+		}
+		catalogTask, err := helper.MakePipelinePlanTask(
+			"taiga",
+			catalogMetas,
+			[]string{plugin.DOMAIN_TYPE_CROSS},
+			TaigaTaskOptions{ConnectionId: connection.ID},
+		)
+		if err != nil {
+			return nil, err
+		}
+		for _, task := range projectTasks {
+			task.Subtasks = slices.DeleteFunc(task.Subtasks, func(name string) bool {
+				return catalogSubtasks[name]
+			})
+		}
+		// a stage of its own has the accounts in place before any project and keeps maxParallelism for projects
+		plan := coreModels.PipelinePlan{coreModels.PipelineStage{catalogTask}}
+		return append(plan, parallelStages(projectTasks, connection.MaxParallelism)...), nil
+	}
+
+	return parallelStages(projectTasks, connection.MaxParallelism), nil
+}
 
-		stage = append(stage, task)
-		plan[i] = stage
+// catalogSubtasks collect data shared by all projects of a connection
+var catalogSubtasks = map[string]bool{
+	tasks.CollectAccountsMeta.Name: true,
+	tasks.ExtractAccountsMeta.Name: true,
+	tasks.ConvertAccountsMeta.Name: true,
+}
+
+// parallelStages runs the tasks side by side, in stages of at most maxParallelism tasks when it is set
+func parallelStages(pipelineTasks []*coreModels.PipelineTask, maxParallelism int) coreModels.PipelinePlan {
+	if maxParallelism <= 0 {
+		maxParallelism = len(pipelineTasks)
+	}
+	plan := make(coreModels.PipelinePlan, 0)
+	for start := 0; start < len(pipelineTasks); start += maxParallelism {
+		end := min(start+maxParallelism, len(pipelineTasks))
+		plan = append(plan, coreModels.PipelineStage(pipelineTasks[start:end]))
 	}
+	return plan
+}
 
-	return plan, nil
+// withDefaultEntities enables every domain type when none was chosen, the optional Taiga entities
+// are expensive and only run when listed
+func withDefaultEntities(entities []string) []string {
+	if len(entities) > 0 {
+		return entities
+	}
+	return plugin.DOMAIN_TYPES
+}
+
+// withDependencies adds the subtasks the chosen ones depend on, directly or not, so an entity
+// enabled on its own still finds the tables it reads, the result follows the order of subtaskMetas
+func withDependencies(subtaskMetas []plugin.SubTaskMeta, subtasks []string) []string {
+	wanted := make(map[string]bool, len(subtasks))
+	var add func(meta *plugin.SubTaskMeta)
+	add = func(meta *plugin.SubTaskMeta) {
+		if wanted[meta.Name] {
+			return
+		}
+		wanted[meta.Name] = true
+		for _, dependency := range meta.Dependencies {
+			add(dependency)
+		}
+	}
+	for i := range subtaskMetas {
+		if slices.Contains(subtasks, subtaskMetas[i].Name) {
+			add(&subtaskMetas[i])
+		}
+	}
+	ordered := make([]string, 0, len(wanted))
+	for _, meta := range subtaskMetas {
+		if wanted[meta.Name] {
+			ordered = append(ordered, meta.Name)
+		}
+	}
+	return ordered
 }
 
 func makeScopesV200(
@@ -112,26 +214,40 @@ func makeScopesV200(
 
 	for _, scopeDetail := range scopeDetails {
 		project := scopeDetail.Scope
+		var entities []string
+		if scopeDetail.ScopeConfig != nil {
+			entities = scopeDetail.ScopeConfig.Entities
+		}
 
-		// add board to scopes
-			entities := scopeDetail.ScopeConfig.Entities
-			hasTicket := false
-			for _, entity := range entities {
-				if entity == plugin.DOMAIN_TYPE_TICKET {
-					hasTicket = true
-					break
-				}
-			}
-			if hasTicket {
-			domainBoard := &ticket.Board{
-				DomainEntity: domainlayer.DomainEntity{
-					Id: idGen.Generate(connection.ID, project.ProjectId),
-				},
-				Name: project.Name,
-			}
-			scopes = append(scopes, domainBoard)
+		// the issues, engagements and documentation activities of a project all hang off its board,
+		// accounts are shared by the connection and belong to no project
+		if !slices.ContainsFunc(withDefaultEntities(entities), boardEntities) {
+			continue
 		}
+		url := project.Url
+		if url == "" {
+			url = connection.ProjectUrl(project.Slug)
+		}
+		domainBoard := &ticket.Board{
+			DomainEntity: domainlayer.DomainEntity{
+				Id: idGen.Generate(connection.ID, project.ProjectId),
+			},
+			Name:        project.Name,
+			Description: project.Description,
+			Url:         url,
+			Type:        project.BoardType(),
+		}
+		scopes = append(scopes, domainBoard)
 	}
 
 	return scopes, nil
 }
+
+// boardEntities tells whether an entity produces domain rows linked to the board of a project
+func boardEntities(entity string) bool {
+	switch entity {
+	case plugin.DOMAIN_TYPE_TICKET, plugin.DOMAIN_TYPE_CROSS, models.ENTITY_TYPE_WIKI:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,104 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api_test
+
+import (
+	"testing"
+
+	"github.com/apache/incubator-devlake/core/plugin"
+	"github.com/irfanuddinahmad/taiga-devlake-plugin/plugins/taiga/api"
+	"github.com/irfanuddinahmad/taiga-devlake-plugin/plugins/taiga/impl"
+	"github.com/irfanuddinahmad/taiga-devlake-plugin/plugins/taiga/models"
+	"github.com/irfanuddinahmad/taiga-devlake-plugin/plugins/taiga/tasks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMakeDataSourcePipelinePlanV200Dependencies(t *testing.T) {
+	connection := &models.TaigaConnection{}
+	connection.ID = 1
+	// history, attachments and wiki pages take one request per item and must stay opt-in
+	itemByItem := []string{
+		tasks.CollectUserStoryHistoriesMeta.Name,
+		tasks.CollectTaskHistoriesMeta.Name,
+		tasks.CollectUserStoryAttachmentsMeta.Name,
+		tasks.CollectWikiHistoriesMeta.Name,
+	}
+
+	tests := []struct {
+		name     string
+		entities []string
+		want     []string
+		wantNot  []string
+	}{
+		{
+			name:     "default entities",
+			entities: nil,
+			want:     []string{tasks.ConvertUserStoriesMeta.Name, tasks.ConvertTasksMeta.Name},
+			wantNot:  itemByItem,
+		},
+		{
+			name:     "tickets",
+			entities: []string{plugin.DOMAIN_TYPE_TICKET},
+			want: []string{
+				tasks.CollectUserStoriesMeta.Name, tasks.CollectTasksMeta.Name, tasks.CollectTaskStatusesMeta.Name,
+				tasks.ConvertUserStoriesMeta.Name, tasks.ConvertTasksMeta.Name,
+			},
+			wantNot: append([]string{tasks.CalculateTaskMetricsMeta.Name, tasks.CollectAccountsMeta.Name}, itemByItem...),
+		},
+		{
+			// comments come with the history of stories and tasks, which is requested item by item
+			name:     "comments alone",
+			entities: []string{models.ENTITY_TYPE_COMMENT},
+			want: []string{
+				tasks.CollectUserStoriesMeta.Name, tasks.ExtractUserStoriesMeta.Name,
+				tasks.CollectTasksMeta.Name, tasks.ExtractTasksMeta.Name,
+				tasks.CollectUserStoryHistoriesMeta.Name, tasks.ExtractUserStoryHistoriesMeta.Name,
+				tasks.CollectTaskHistoriesMeta.Name, tasks.ExtractTaskHistoriesMeta.Name,
+				tasks.ConvertIssueCommentsMeta.Name,
+			},
+			wantNot: []string{tasks.ConvertUserStoriesMeta.Name, tasks.CalculateTaskMetricsMeta.Name},
+		},
+		{
+			name:     "history",
+			entities: []string{plugin.DOMAIN_TYPE_TICKET, models.ENTITY_TYPE_HISTORY},
+			want: []string{
+				tasks.CollectTaskHistoriesMeta.Name, tasks.CalculateTaskMetricsMeta.Name,
+				tasks.CalculateBlockedPeriodsMeta.Name, tasks.ConvertTasksMeta.Name,
+			},
+			wantNot: []string{tasks.CollectWikiPagesMeta.Name, tasks.CollectUserStoryAttachmentsMeta.Name},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan, err := api.MakeDataSourcePipelinePlan(impl.Taiga{}.SubTaskMetas(), api.MakeScopeDetails(tt.entities, 11), connection)
+			require.Nil(t, err)
+			require.NotEmpty(t, plan)
+			// the project task runs last, after the catalog stage when there is one
+			projectStage := plan[len(plan)-1]
+			require.Len(t, projectStage, 1)
+			subtasks := projectStage[0].Subtasks
+			for _, name := range tt.want {
+				assert.Contains(t, subtasks, name)
+			}
+			for _, name := range tt.wantNot {
+				assert.NotContains(t, subtasks, name)
+			}
+		})
+	}
+}
@@ -0,0 +1,180 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"slices"
+	"testing"
+
+	coreModels "github.com/apache/incubator-devlake/core/models"
+	"github.com/apache/incubator-devlake/core/models/domainlayer/ticket"
+	"github.com/apache/incubator-devlake/core/plugin"
+	"github.com/apache/incubator-devlake/helpers/srvhelper"
+	"github.com/irfanuddinahmad/taiga-devlake-plugin/plugins/taiga/models"
+	"github.com/irfanuddinahmad/taiga-devlake-plugin/plugins/taiga/tasks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// planSubtaskMetas is a slice of the subtasks of the plugin, in the order the plugin lists them
+var planSubtaskMetas = []plugin.SubTaskMeta{
+	tasks.CollectAccountsMeta,
+	tasks.ExtractAccountsMeta,
+	tasks.CollectUserStoriesMeta,
+	tasks.ExtractUserStoriesMeta,
+	tasks.CollectTasksMeta,
+	tasks.ExtractTasksMeta,
+	tasks.CollectUserStoryHistoriesMeta,
+	tasks.ExtractUserStoryHistoriesMeta,
+	tasks.CollectTaskHistoriesMeta,
+	tasks.ExtractTaskHistoriesMeta,
+	tasks.ConvertAccountsMeta,
+	tasks.ConvertUserStoriesMeta,
+	tasks.ConvertIssueCommentsMeta,
+}
+
+func makeScopeDetails(entities []string, projectIds ...uint64) []*srvhelper.ScopeDetail[models.TaigaProject, models.TaigaScopeConfig] {
+	scopeConfig := &models.TaigaScopeConfig{}
+	scopeConfig.Entities = entities
+	scopeDetails := make([]*srvhelper.ScopeDetail[models.TaigaProject, models.TaigaScopeConfig], 0, len(projectIds))
+	for _, projectId := range projectIds {
+		project := &models.TaigaProject{ProjectId: projectId}
+		project.ConnectionId = 1
+		scopeDetails = append(scopeDetails, &srvhelper.ScopeDetail[models.TaigaProject, models.TaigaScopeConfig]{
+			Scope:       project,
+			ScopeConfig: scopeConfig,
+		})
+	}
+	return scopeDetails
+}
+
+func TestParallelStages(t *testing.T) {
+	pipelineTasks := make([]*coreModels.PipelineTask, 5)
+	for i := range pipelineTasks {
+		pipelineTasks[i] = &coreModels.PipelineTask{Plugin: "taiga"}
+	}
+	tests := []struct {
+		name           string
+		tasks          []*coreModels.PipelineTask
+		maxParallelism int
+		want           []int
+	}{
+		{"no tasks", nil, 0, []int{}},
+		{"unlimited", pipelineTasks, 0, []int{5}},
+		{"negative is unlimited", pipelineTasks, -1, []int{5}},
+		{"split evenly", pipelineTasks[:4], 2, []int{2, 2}},
+		{"last stage takes the rest", pipelineTasks, 2, []int{2, 2, 1}},
+		{"one at a time", pipelineTasks[:3], 1, []int{1, 1, 1}},
+		{"limit above the task count", pipelineTasks[:3], 10, []int{3}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan := parallelStages(tt.tasks, tt.maxParallelism)
+			sizes := make([]int, 0, len(plan))
+			for _, stage := range plan {
+				sizes = append(sizes, len(stage))
+			}
+			assert.Equal(t, tt.want, sizes)
+		})
+	}
+}
+
+func TestMakeDataSourcePipelinePlanV200CatalogStage(t *testing.T) {
+	connection := &models.TaigaConnection{}
+	connection.ID = 1
+	connection.MaxParallelism = 2
+
+	// the accounts are collected once, ahead of the projects and outside of their stages
+	plan, err := makeDataSourcePipelinePlanV200(planSubtaskMetas, makeScopeDetails(plugin.DOMAIN_TYPES, 11, 12, 13), connection)
+	require.Nil(t, err)
+	require.Len(t, plan, 3)
+	require.Len(t, plan[0], 1)
+	assert.Equal(t, []string{
+		tasks.CollectAccountsMeta.Name, tasks.ExtractAccountsMeta.Name, tasks.ConvertAccountsMeta.Name,
+	}, plan[0][0].Subtasks)
+	assert.EqualValues(t, 1, plan[0][0].Options["connectionId"])
+	assert.EqualValues(t, 0, plan[0][0].Options["projectId"])
+	assert.Len(t, plan[1], 2)
+	assert.Len(t, plan[2], 1)
+	for _, stage := range plan[1:] {
+		for _, task := range stage {
+			assert.NotContains(t, task.Subtasks, tasks.CollectAccountsMeta.Name)
+			assert.Contains(t, task.Subtasks, tasks.CollectUserStoriesMeta.Name)
+		}
+	}
+
+	// without CROSS there is no catalog stage
+	plan, err = makeDataSourcePipelinePlanV200(planSubtaskMetas, makeScopeDetails([]string{plugin.DOMAIN_TYPE_TICKET}, 11, 12, 13), connection)
+	require.Nil(t, err)
+	assert.Len(t, plan, 2)
+}
+
+func TestMakeScopesV200(t *testing.T) {
+	connection := &models.TaigaConnection{}
+	connection.ID = 1
+	connection.Endpoint = "https://taiga.example.com/api/v1/"
+
+	withUrl := &models.TaigaProject{ProjectId: 11, Name: "Web", Slug: "acme-web", Url: "https://boards.example.com/web"}
+	kanban := &models.TaigaProject{ProjectId: 12, Name: "Ops", Slug: "acme-ops", Description: "Run book", IsKanbanActivated: true}
+	attachmentsOnly := &models.TaigaScopeConfig{}
+	attachmentsOnly.Entities = []string{models.ENTITY_TYPE_ATTACHMENT}
+	scopeDetails := []*srvhelper.ScopeDetail[models.TaigaProject, models.TaigaScopeConfig]{
+		// discovered projects may have no scope config
+		{Scope: withUrl},
+		{Scope: kanban, ScopeConfig: &models.TaigaScopeConfig{}},
+		{Scope: &models.TaigaProject{ProjectId: 13, Slug: "acme-files"}, ScopeConfig: attachmentsOnly},
+	}
+
+	scopes, err := makeScopesV200(scopeDetails, connection)
+	require.Nil(t, err)
+	require.Len(t, scopes, 2)
+	web := scopes[0].(*ticket.Board)
+	assert.Equal(t, "taiga:TaigaProject:1:11", web.Id)
+	assert.Equal(t, "https://boards.example.com/web", web.Url)
+	assert.Equal(t, "scrum", web.Type)
+	ops := scopes[1].(*ticket.Board)
+	assert.Equal(t, "taiga:TaigaProject:1:12", ops.Id)
+	assert.Equal(t, "Ops", ops.Name)
+	assert.Equal(t, "Run book", ops.Description)
+	assert.Equal(t, "https://taiga.example.com/project/acme-ops", ops.Url)
+	assert.Equal(t, "kanban", ops.Type)
+}
+
+func TestWithDefaultEntities(t *testing.T) {
+	tests := []struct {
+		name     string
+		entities []string
+		want     []string
+	}{
+		{"nil picks the domain types", nil, plugin.DOMAIN_TYPES},
+		{"empty picks the domain types", []string{}, plugin.DOMAIN_TYPES},
+		{"chosen entities are kept", []string{plugin.DOMAIN_TYPE_TICKET, models.ENTITY_TYPE_WIKI}, []string{plugin.DOMAIN_TYPE_TICKET, models.ENTITY_TYPE_WIKI}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := withDefaultEntities(tt.entities)
+			assert.Equal(t, tt.want, got)
+			// the optional Taiga entities are opt-in
+			for _, entity := range models.ENTITY_TYPES {
+				if !slices.Contains(tt.entities, entity) {
+					assert.NotContains(t, got, entity)
+				}
+			}
+		})
+	}
+}
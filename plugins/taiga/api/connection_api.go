@@ -21,18 +21,48 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"net/url"
+	"strings"
 
 	"github.com/apache/incubator-devlake/core/errors"
 	"github.com/apache/incubator-devlake/core/plugin"
 	"github.com/apache/incubator-devlake/helpers/pluginhelper/api"
-	"github.com/irfanuddinahmad/taiga-devlake-plugin/plugins/taiga/models"
 	"github.com/apache/incubator-devlake/server/api/shared"
+	"github.com/irfanuddinahmad/taiga-devlake-plugin/plugins/taiga/models"
 )
 
 // TaigaTestConnResponse is the response struct for testing a connection
 type TaigaTestConnResponse struct {
 	shared.ApiBody
-	Connection *models.TaigaConnection
+	Connection  *models.TaigaConnection
+	Diagnostics *TaigaConnDiagnostics `json:"diagnostics"`
+}
+
+// TaigaConnDiagnostics describes what the token is able to see on the Taiga server
+type TaigaConnDiagnostics struct {
+	User *TaigaApiUser `json:"user"`
+	// VisibleProjectCount counts every project the token can read, public projects included
+	VisibleProjectCount int `json:"visibleProjectCount"`
+	// MemberProjectCount only counts projects the user is a member of
+	MemberProjectCount int                    `json:"memberProjectCount"`
+	SampleProject      *TaigaApiProject       `json:"sampleProject"`
+	Permissions        []TaigaPermissionCheck `json:"permissions"`
+}
+
+// TaigaApiUser is the subset of `users/me` we report back
+type TaigaApiUser struct {
+	Id       uint64 `json:"id"`
+	Username string `json:"username"`
+	FullName string `json:"full_name"`
+	Email    string `json:"email"`
+}
+
+// TaigaPermissionCheck is the outcome of probing one resource of the sample project
+type TaigaPermissionCheck struct {
+	Resource   string `json:"resource"`
+	Readable   bool   `json:"readable"`
+	StatusCode int    `json:"statusCode"`
+	Message    string `json:"message,omitempty"`
 }
 
 // testConnection tests the Taiga connection
@@ -44,6 +74,10 @@ func testConnection(ctx context.Context, connection models.TaigaConnection) (*Ta
 		}
 	}
 
+	if _, err := models.NormalizeEndpoint(connection.Endpoint, connection.ApiPrefix); err != nil {
+		return nil, err
+	}
+
 	apiClient, err := api.NewApiClientFromConnection(ctx, basicRes, &connection)
 	if err != nil {
 		return nil, err
@@ -63,15 +97,130 @@ func testConnection(ctx context.Context, connection models.TaigaConnection) (*Ta
 		return nil, errors.HttpStatus(res.StatusCode).New(fmt.Sprintf("unexpected status code: %d", res.StatusCode))
 	}
 
+	diagnostics := &TaigaConnDiagnostics{
+		User: &TaigaApiUser{},
+	}
+	err = api.UnmarshalResponse(res, diagnostics.User)
+	if err != nil {
+		return nil, errors.Default.Wrap(err, "failed to parse the authenticated user")
+	}
+	diagnoseConnection(apiClient, diagnostics)
+
 	connection = connection.Sanitize()
 	body := TaigaTestConnResponse{}
 	body.Success = true
-	body.Message = "success"
+	body.Message = diagnostics.summary()
 	body.Connection = &connection
+	body.Diagnostics = diagnostics
 
 	return &body, nil
 }
 
+// diagnoseConnection counts the projects the token can see and the ones the user is a member of, then
+// probes one of them for read access to its items, failures are reported instead of failing the test
+func diagnoseConnection(apiClient *api.ApiClient, diagnostics *TaigaConnDiagnostics) {
+	visible, ok := listProjects(apiClient, "projects", nil, diagnostics)
+	if !ok {
+		return
+	}
+	diagnostics.VisibleProjectCount = len(visible)
+	memberQuery := url.Values{}
+	memberQuery.Set("member", fmt.Sprintf("%d", diagnostics.User.Id))
+	member, ok := listProjects(apiClient, "member projects", memberQuery, diagnostics)
+	if !ok {
+		return
+	}
+	diagnostics.MemberProjectCount = len(member)
+
+	// the projects of the user are the ones it is expected to collect
+	projects := member
+	if len(projects) == 0 {
+		projects = visible
+	}
+	if len(projects) == 0 {
+		return
+	}
+
+	diagnostics.SampleProject = &projects[0]
+	projectQuery := url.Values{}
+	projectQuery.Set("project", fmt.Sprintf("%d", diagnostics.SampleProject.Id))
+	projectQuery.Set("page_size", "1")
+	var sampleStories []struct {
+		Id uint64 `json:"id"`
+	}
+	for _, resource := range []string{"userstories", "tasks", "issues"} {
+		var target interface{}
+		if resource == "userstories" {
+			target = &sampleStories
+		}
+		diagnostics.Permissions = append(diagnostics.Permissions, probeResource(apiClient, resource, resource, projectQuery, target))
+	}
+	if len(sampleStories) == 0 {
+		diagnostics.Permissions = append(diagnostics.Permissions, TaigaPermissionCheck{
+			Resource: "history",
+			Message:  "no user story available in the sample project to read history from",
+		})
+		return
+	}
+	historyPath := fmt.Sprintf("history/userstory/%d", sampleStories[0].Id)
+	diagnostics.Permissions = append(diagnostics.Permissions, probeResource(apiClient, "history", historyPath, nil, nil))
+}
+
+// listProjects lists the projects matching query, Taiga only paginates them when asked to, a failure
+// is recorded as an unreadable resource
+func listProjects(apiClient *api.ApiClient, resource string, query url.Values, diagnostics *TaigaConnDiagnostics) ([]TaigaApiProject, bool) {
+	var projects []TaigaApiProject
+	check := probeResource(apiClient, resource, "projects", query, &projects)
+	if !check.Readable || check.Message != "" {
+		check.Readable = false
+		diagnostics.Permissions = append(diagnostics.Permissions, check)
+		return nil, false
+	}
+	return projects, true
+}
+
+// probeResource issues a GET against path and records whether it was readable, the body is decoded into target when given
+func probeResource(apiClient *api.ApiClient, resource string, path string, query url.Values, target interface{}) TaigaPermissionCheck {
+	check := TaigaPermissionCheck{Resource: resource}
+	res, err := apiClient.Get(path, query, nil)
+	if err != nil {
+		check.Message = err.Error()
+		return check
+	}
+	check.StatusCode = res.StatusCode
+	check.Readable = res.StatusCode == http.StatusOK
+	if !check.Readable || target == nil {
+		res.Body.Close()
+		if !check.Readable {
+			check.Message = http.StatusText(res.StatusCode)
+		}
+		return check
+	}
+	if err = api.UnmarshalResponse(res, target); err != nil {
+		check.Message = err.Error()
+	}
+	return check
+}
+
+func (d *TaigaConnDiagnostics) summary() string {
+	var unreadable []string
+	for _, check := range d.Permissions {
+		if !check.Readable {
+			unreadable = append(unreadable, check.Resource)
+		}
+	}
+	if d.SampleProject == nil {
+		if len(unreadable) > 0 {
+			return fmt.Sprintf("success, but the token cannot list %s", strings.Join(unreadable, ", "))
+		}
+		return "success, but the token cannot see any project"
+	}
+	if len(unreadable) > 0 {
+		return fmt.Sprintf("success, but the token cannot read %s of project %s", strings.Join(unreadable, ", "), d.SampleProject.Name)
+	}
+	return "success"
+}
+
 // TestConnection tests the Taiga connection
 // @Summary test taiga connection
 // @Description Test Taiga Connection
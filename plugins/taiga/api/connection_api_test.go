@@ -0,0 +1,55 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTaigaConnDiagnosticsSummary(t *testing.T) {
+	project := &TaigaApiProject{Name: "Project Alpha"}
+	tests := []struct {
+		name        string
+		diagnostics TaigaConnDiagnostics
+		want        string
+	}{
+		{"no project", TaigaConnDiagnostics{}, "success, but the token cannot see any project"},
+		{"projects cannot be listed", TaigaConnDiagnostics{
+			Permissions: []TaigaPermissionCheck{{Resource: "projects", StatusCode: 500, Message: "Internal Server Error"}},
+		}, "success, but the token cannot list projects"},
+		{"all readable", TaigaConnDiagnostics{
+			SampleProject: project,
+			Permissions:   []TaigaPermissionCheck{{Resource: "userstories", Readable: true}},
+		}, "success"},
+		{"some unreadable", TaigaConnDiagnostics{
+			SampleProject: project,
+			Permissions: []TaigaPermissionCheck{
+				{Resource: "userstories", Readable: true},
+				{Resource: "tasks"},
+				{Resource: "issues"},
+			},
+		}, "success, but the token cannot read tasks, issues of project Project Alpha"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.diagnostics.summary())
+		})
+	}
+}
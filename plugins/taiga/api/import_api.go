@@ -0,0 +1,110 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/plugin"
+	"github.com/irfanuddinahmad/taiga-devlake-plugin/plugins/taiga/tasks"
+)
+
+// ImportProjectExport writes a Taiga project export into the raw tables of a scope
+// @Summary import a taiga project export
+// @Description Import a file from Taiga's "export project" feature into the raw tables of a scope, either uploaded as the body or read from {"path": "..."} under the TAIGA_IMPORT_DIR of the DevLake server. Run the extractors and convertors of the scope afterwards with replayRawData.
+// @Tags plugins/taiga
+// @Param connectionId path int true "connection ID"
+// @Param scopeId path int true "project ID"
+// @Success 200  {object} tasks.TaigaImportResult "Success"
+// @Failure 400  {string} errcode.Error "Bad Request"
+// @Failure 500  {string} errcode.Error "Internal Error"
+// @Router /plugins/taiga/connections/:connectionId/scopes/:scopeId/import [POST]
+func ImportProjectExport(input *plugin.ApiResourceInput) (*plugin.ApiResourceOutput, errors.Error) {
+	connectionId, parseErr := strconv.ParseUint(input.Params["connectionId"], 10, 64)
+	if parseErr != nil {
+		return nil, errors.BadInput.Wrap(parseErr, "invalid connectionId")
+	}
+	projectId, parseErr := strconv.ParseUint(input.Params["scopeId"], 10, 64)
+	if parseErr != nil {
+		return nil, errors.BadInput.Wrap(parseErr, "invalid scopeId")
+	}
+	if _, err := dsHelper.ConnSrv.FindByPk(connectionId); err != nil {
+		return nil, errors.BadInput.Wrap(err, "find connection from db")
+	}
+
+	var raw []byte
+	var readErr error
+	if path, ok := input.Body["path"].(string); ok && len(input.Body) == 1 {
+		resolved, err := resolveImportPath(basicRes.GetConfig(importDirConfig), path)
+		if err != nil {
+			return nil, err
+		}
+		raw, readErr = os.ReadFile(resolved)
+	} else {
+		raw, readErr = json.Marshal(input.Body)
+	}
+	if readErr != nil {
+		return nil, errors.BadInput.Wrap(readErr, "cannot read the project export")
+	}
+	var export tasks.TaigaProjectExport
+	if jsonErr := json.Unmarshal(raw, &export); jsonErr != nil {
+		return nil, errors.BadInput.Wrap(jsonErr, "not a Taiga project export")
+	}
+
+	result, err := tasks.ImportProjectExport(basicRes.GetDal(), connectionId, projectId, &export)
+	if err != nil {
+		return nil, err
+	}
+	return &plugin.ApiResourceOutput{Body: result, Status: http.StatusOK}, nil
+}
+
+// importDirConfig names the directory export files may be read from, reading paths is disabled without it
+const importDirConfig = "TAIGA_IMPORT_DIR"
+
+// resolveImportPath resolves path, relative to importDir unless absolute, and rejects it unless it
+// stays inside importDir once symlinks are followed
+func resolveImportPath(importDir string, path string) (string, errors.Error) {
+	if importDir == "" {
+		return "", errors.BadInput.New("importing from a path is disabled, set " + importDirConfig + " or upload the export as the body")
+	}
+	root, err := filepath.Abs(importDir)
+	if err == nil {
+		root, err = filepath.EvalSymlinks(root)
+	}
+	if err != nil {
+		return "", errors.Default.Wrap(err, "invalid "+importDirConfig)
+	}
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(root, path)
+	}
+	resolved, err := filepath.EvalSymlinks(filepath.Clean(path))
+	if err != nil {
+		return "", errors.BadInput.Wrap(err, "cannot read the project export")
+	}
+	rel, err := filepath.Rel(root, resolved)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", errors.BadInput.New("the project export must be inside " + importDirConfig)
+	}
+	return resolved, nil
+}
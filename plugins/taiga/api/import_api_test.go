@@ -0,0 +1,78 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveImportPath(t *testing.T) {
+	base := t.TempDir()
+	importDir := filepath.Join(base, "exports")
+	require.NoError(t, os.MkdirAll(filepath.Join(importDir, "archive"), 0o755))
+	export := filepath.Join(importDir, "archive", "project.json")
+	require.NoError(t, os.WriteFile(export, []byte("{}"), 0o600))
+	secret := filepath.Join(base, "secret.json")
+	require.NoError(t, os.WriteFile(secret, []byte("{}"), 0o600))
+	sibling := filepath.Join(base, "exports-old", "project.json")
+	require.NoError(t, os.MkdirAll(filepath.Dir(sibling), 0o755))
+	require.NoError(t, os.WriteFile(sibling, []byte("{}"), 0o600))
+	require.NoError(t, os.Symlink(secret, filepath.Join(importDir, "link.json")))
+	require.NoError(t, os.Symlink(export, filepath.Join(importDir, "inside.json")))
+	// the import dir may itself be reached through a symlink
+	linkedDir := filepath.Join(base, "linked-exports")
+	require.NoError(t, os.Symlink(importDir, linkedDir))
+	resolvedExport, err := filepath.EvalSymlinks(export)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		importDir string
+		path      string
+		want      string
+		wantErr   bool
+	}{
+		{"disabled without an import dir", "", export, "", true},
+		{"absolute path inside", importDir, export, resolvedExport, false},
+		{"relative path inside", importDir, "archive/project.json", resolvedExport, false},
+		{"import dir behind a symlink", linkedDir, "archive/project.json", resolvedExport, false},
+		{"symlink staying inside", importDir, "inside.json", resolvedExport, false},
+		{"dot segments are cleaned", importDir, "archive/../archive/project.json", resolvedExport, false},
+		{"absolute path outside", importDir, secret, "", true},
+		{"dot segments leaving the dir", importDir, "../secret.json", "", true},
+		{"symlink leaving the dir", importDir, "link.json", "", true},
+		{"sibling sharing the prefix", importDir, sibling, "", true},
+		{"missing file", importDir, "archive/missing.json", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := resolveImportPath(tt.importDir, tt.path)
+			if tt.wantErr {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,112 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/apache/incubator-devlake/core/dal"
+	"github.com/apache/incubator-devlake/core/errors"
+	coreModels "github.com/apache/incubator-devlake/core/models"
+	"github.com/apache/incubator-devlake/core/plugin"
+	"github.com/apache/incubator-devlake/helpers/pluginhelper/api"
+	"github.com/irfanuddinahmad/taiga-devlake-plugin/plugins/taiga/models"
+)
+
+// discoverProjects appends the remote projects of the user matching the patterns of the connection to
+// the blueprint scopes, the projects seen for the first time are saved as scopes with the default
+// scope config of the connection. Public projects the user is not a member of are left out, shared
+// instances such as tree.taiga.io list thousands of them.
+func discoverProjects(
+	apiClient plugin.ApiClient,
+	connection *models.TaigaConnection,
+	bpScopes []*coreModels.BlueprintScope,
+) ([]*coreModels.BlueprintScope, errors.Error) {
+	matches, err := connection.DiscoveredProjectFilter()
+	if err != nil {
+		return nil, err
+	}
+	planned := make(map[string]bool, len(bpScopes))
+	for _, bpScope := range bpScopes {
+		planned[bpScope.ScopeId] = true
+	}
+
+	me, err := currentTaigaUser(apiClient)
+	if err != nil {
+		return nil, err
+	}
+
+	db := basicRes.GetDal()
+	page := TaigaRemotePagination{}
+	for {
+		children, nextPage, queryErr := queryTaigaProjects(apiClient, "", me.Id, page)
+		if queryErr != nil {
+			return nil, errors.Default.Wrap(queryErr, "failed to list Taiga projects for the auto-discovery")
+		}
+		for _, child := range children {
+			project := child.Data
+			if planned[child.Id] || !matches(project.Name, project.Slug) {
+				continue
+			}
+			err = saveDiscoveredProject(db, connection, project)
+			if err != nil {
+				return nil, err
+			}
+			planned[child.Id] = true
+			bpScopes = append(bpScopes, &coreModels.BlueprintScope{ScopeId: child.Id})
+		}
+		if nextPage == nil {
+			return bpScopes, nil
+		}
+		page = *nextPage
+	}
+}
+
+// currentTaigaUser returns the user the token of the connection belongs to
+func currentTaigaUser(apiClient plugin.ApiClient) (*TaigaApiUser, errors.Error) {
+	res, err := apiClient.Get("users/me", nil, nil)
+	if err != nil {
+		return nil, errors.Default.Wrap(err, "failed to read the Taiga user for the auto-discovery")
+	}
+	if res.StatusCode != http.StatusOK {
+		res.Body.Close()
+		return nil, errors.HttpStatus(res.StatusCode).New(fmt.Sprintf("unexpected status code when reading the Taiga user: %d", res.StatusCode))
+	}
+	user := &TaigaApiUser{}
+	err = api.UnmarshalResponse(res, user)
+	if err != nil {
+		return nil, errors.Default.Wrap(err, "failed to parse the Taiga user")
+	}
+	return user, nil
+}
+
+// saveDiscoveredProject creates the scope of a discovered project, existing scopes keep their scope config
+func saveDiscoveredProject(db dal.Dal, connection *models.TaigaConnection, project *models.TaigaProject) errors.Error {
+	var existing models.TaigaProject
+	err := db.First(&existing, dal.Where("connection_id = ? AND project_id = ?", connection.ID, project.ProjectId))
+	if err == nil {
+		return nil
+	}
+	if !db.IsErrorNotFound(err) {
+		return errors.Default.Wrap(err, fmt.Sprintf("fail to find project: %d", project.ProjectId))
+	}
+	project.ConnectionId = connection.ID
+	project.ScopeConfigId = connection.DefaultScopeConfigId
+	return db.Create(project)
+}
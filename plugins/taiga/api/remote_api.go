@@ -40,9 +40,12 @@ type TaigaApiProject struct {
 	Description string `json:"description"`
 }
 
+// queryTaigaProjects lists one page of the projects matching keyword, only the projects of the
+// member are listed when memberId is set
 func queryTaigaProjects(
 	apiClient plugin.ApiClient,
 	keyword string,
+	memberId uint64,
 	page TaigaRemotePagination,
 ) (
 	children []dsmodels.DsRemoteApiScopeListEntry[models.TaigaProject],
@@ -63,8 +66,11 @@ func queryTaigaProjects(
 	if keyword != "" {
 		query.Set("search", keyword)
 	}
+	if memberId != 0 {
+		query.Set("member", fmt.Sprintf("%d", memberId))
+	}
 
-	res, err := apiClient.Get("api/v1/projects", query, nil)
+	res, err := apiClient.Get("projects", query, nil)
 	if err != nil {
 		return
 	}
@@ -112,7 +118,7 @@ func listTaigaRemoteScopes(
 	nextPage *TaigaRemotePagination,
 	err errors.Error,
 ) {
-	return queryTaigaProjects(apiClient, "", page)
+	return queryTaigaProjects(apiClient, "", 0, page)
 }
 
 // RemoteScopes list all available scopes on the remote server
@@ -145,6 +151,6 @@ func searchTaigaRemoteProjects(
 		PageSize: params.PageSize,
 		Page:     params.Page,
 	}
-	children, _, err = queryTaigaProjects(apiClient, params.Search, page)
+	children, _, err = queryTaigaProjects(apiClient, params.Search, 0, page)
 	return
 }
@@ -0,0 +1,59 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package e2e
+
+import (
+	"testing"
+
+	"github.com/apache/incubator-devlake/helpers/e2ehelper"
+	"github.com/irfanuddinahmad/taiga-devlake-plugin/plugins/taiga/impl"
+	"github.com/irfanuddinahmad/taiga-devlake-plugin/plugins/taiga/models"
+	"github.com/irfanuddinahmad/taiga-devlake-plugin/plugins/taiga/tasks"
+)
+
+func TestTaigaAttachmentDataFlow(t *testing.T) {
+	var taiga impl.Taiga
+	dataflowTester := e2ehelper.NewDataFlowTester(t, "taiga", taiga)
+	fake := newTaigaFake(t)
+	taskData := newFakeTaskData(t, dataflowTester, fake, fakeToken)
+
+	// the attachments are requested story by story and task by task
+	dataflowTester.FlushRawTable(tasks.RAW_USER_STORY_TABLE)
+	dataflowTester.FlushRawTable(tasks.RAW_USER_STORY_ATTACHMENT_TABLE)
+	dataflowTester.FlushRawTable(tasks.RAW_TASK_TABLE)
+	dataflowTester.FlushRawTable(tasks.RAW_TASK_ATTACHMENT_TABLE)
+	for _, table := range toolTables {
+		dataflowTester.FlushTabler(table)
+	}
+	dataflowTester.FlushTabler(&models.TaigaAttachment{})
+	dataflowTester.Subtask(tasks.CollectUserStoriesMeta, taskData)
+	dataflowTester.Subtask(tasks.ExtractUserStoriesMeta, taskData)
+	dataflowTester.Subtask(tasks.CollectUserStoryAttachmentsMeta, taskData)
+	dataflowTester.Subtask(tasks.ExtractUserStoryAttachmentsMeta, taskData)
+	dataflowTester.Subtask(tasks.CollectTasksMeta, taskData)
+	dataflowTester.Subtask(tasks.ExtractTasksMeta, taskData)
+	dataflowTester.Subtask(tasks.CollectTaskAttachmentsMeta, taskData)
+	dataflowTester.Subtask(tasks.ExtractTaskAttachmentsMeta, taskData)
+	dataflowTester.VerifyTableWithOptions(models.TaigaAttachment{}, e2ehelper.TableOptions{
+		CSVRelPath: "./snapshot_tables/_tool_taiga_attachments.csv",
+		TargetFields: []string{
+			"connection_id", "attachment_id", "item_type", "item_id", "project_id", "name", "size",
+			"owner_id", "created_date", "is_deprecated",
+		},
+	})
+}
@@ -0,0 +1,84 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package e2e
+
+import (
+	"testing"
+
+	"github.com/apache/incubator-devlake/core/models/common"
+	"github.com/apache/incubator-devlake/core/models/domainlayer/ticket"
+	"github.com/apache/incubator-devlake/helpers/e2ehelper"
+	"github.com/irfanuddinahmad/taiga-devlake-plugin/plugins/taiga/impl"
+	"github.com/irfanuddinahmad/taiga-devlake-plugin/plugins/taiga/models"
+	"github.com/irfanuddinahmad/taiga-devlake-plugin/plugins/taiga/tasks"
+)
+
+func TestTaigaCustomAttributeDataFlow(t *testing.T) {
+	var taiga impl.Taiga
+	dataflowTester := e2ehelper.NewDataFlowTester(t, "taiga", taiga)
+	fake := newTaigaFake(t)
+	taskData := newFakeTaskData(t, dataflowTester, fake, fakeToken)
+	taskData.Options.ScopeConfig.CustomAttributeMappings = map[string]models.CustomAttributeMapping{
+		models.IssueFieldComponent:   {Attribute: "Component"},
+		models.IssueFieldDuplicateOf: {Attribute: "Duplicate of"},
+	}
+
+	// collect and extract, the story attribute "Component" and the task attribute "Duplicate of"
+	// share the id 41
+	dataflowTester.FlushRawTable(tasks.RAW_USER_STORY_TABLE)
+	dataflowTester.FlushRawTable(tasks.RAW_TASK_TABLE)
+	dataflowTester.FlushRawTable(tasks.RAW_TASK_STATUS_TABLE)
+	dataflowTester.FlushRawTable(tasks.RAW_CUSTOM_ATTRIBUTE_TABLE)
+	dataflowTester.FlushRawTable(tasks.RAW_CUSTOM_ATTRIBUTE_VALUE_TABLE)
+	dataflowTester.FlushRawTable(tasks.RAW_TASK_CUSTOM_ATTRIBUTE_VALUE_TABLE)
+	for _, table := range toolTables {
+		dataflowTester.FlushTabler(table)
+	}
+	dataflowTester.Subtask(tasks.CollectUserStoriesMeta, taskData)
+	dataflowTester.Subtask(tasks.ExtractUserStoriesMeta, taskData)
+	dataflowTester.Subtask(tasks.CollectTaskStatusesMeta, taskData)
+	dataflowTester.Subtask(tasks.ExtractTaskStatusesMeta, taskData)
+	dataflowTester.Subtask(tasks.CollectTasksMeta, taskData)
+	dataflowTester.Subtask(tasks.ExtractTasksMeta, taskData)
+	dataflowTester.Subtask(tasks.CollectCustomAttributesMeta, taskData)
+	dataflowTester.Subtask(tasks.ExtractCustomAttributesMeta, taskData)
+	dataflowTester.Subtask(tasks.CollectCustomAttributeValuesMeta, taskData)
+	dataflowTester.Subtask(tasks.ExtractCustomAttributeValuesMeta, taskData)
+	dataflowTester.Subtask(tasks.CollectTaskCustomAttributeValuesMeta, taskData)
+	dataflowTester.Subtask(tasks.ExtractTaskCustomAttributeValuesMeta, taskData)
+	dataflowTester.VerifyTableWithOptions(models.TaigaCustomAttribute{}, e2ehelper.TableOptions{
+		CSVRelPath:   "./snapshot_tables/_tool_taiga_custom_attributes.csv",
+		TargetFields: []string{"connection_id", "item_type", "attribute_id", "project_id", "name", "type"},
+	})
+	dataflowTester.VerifyTableWithOptions(models.TaigaCustomAttributeValue{}, e2ehelper.TableOptions{
+		CSVRelPath:   "./snapshot_tables/_tool_taiga_custom_attribute_values.csv",
+		TargetFields: []string{"connection_id", "item_type", "item_id", "attribute_id", "project_id", "value"},
+	})
+
+	// convert, only the story gets a component, the ref on task 202 stays off the task issues
+	dataflowTester.FlushTabler(&ticket.Issue{})
+	dataflowTester.FlushTabler(&ticket.BoardIssue{})
+	dataflowTester.FlushTabler(&ticket.IssueWorklog{})
+	dataflowTester.Subtask(tasks.ConvertUserStoriesMeta, taskData)
+	dataflowTester.Subtask(tasks.ConvertTasksMeta, taskData)
+	dataflowTester.VerifyTableWithOptions(ticket.Issue{}, e2ehelper.TableOptions{
+		CSVRelPath:   "./snapshot_tables/custom_attribute_issues.csv",
+		TargetFields: []string{"id", "component"},
+		IgnoreTypes:  []interface{}{common.NoPKModel{}},
+	})
+}
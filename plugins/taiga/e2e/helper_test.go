@@ -0,0 +1,75 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package e2e
+
+import (
+	"context"
+	"testing"
+
+	"github.com/apache/incubator-devlake/core/runner"
+	"github.com/apache/incubator-devlake/helpers/e2ehelper"
+	contextimpl "github.com/apache/incubator-devlake/impls/context"
+	"github.com/irfanuddinahmad/taiga-devlake-plugin/plugins/taiga/models"
+	"github.com/irfanuddinahmad/taiga-devlake-plugin/plugins/taiga/tasks"
+	"github.com/stretchr/testify/require"
+)
+
+// newFakeTaskData prepares the task data of project 1 with an api client talking to the fake
+func newFakeTaskData(t *testing.T, dataflowTester *e2ehelper.DataFlowTester, fake *taigaFake, token string) *tasks.TaigaTaskData {
+	connection := &models.TaigaConnection{}
+	connection.ID = 1
+	connection.Endpoint = fake.URL
+	connection.Token = token
+	connection.RateLimitPerHour = 36000
+
+	basicRes := runner.CreateBasicRes(dataflowTester.Cfg, dataflowTester.Log, dataflowTester.Db)
+	taskCtx := contextimpl.NewStandaloneTaskContext(context.Background(), basicRes, "taiga", nil)
+	apiClient, throttle, err := tasks.NewTaigaApiClient(taskCtx, connection)
+	require.NoError(t, err)
+
+	return &tasks.TaigaTaskData{
+		Options: &tasks.TaigaOptions{
+			ConnectionId: 1,
+			ProjectId:    1,
+			ScopeConfig:  &models.TaigaScopeConfig{},
+		},
+		ApiClient:  apiClient,
+		Throttle:   throttle,
+		Connection: connection,
+	}
+}
+
+// toolTables are flushed before every data flow, extractors write into several of them at once
+var toolTables = []interface{}{
+	&models.TaigaProject{},
+	&models.TaigaRole{},
+	&models.TaigaPoint{},
+	&models.TaigaUserStory{},
+	&models.TaigaUserStoryRolePoint{},
+	&models.TaigaIssueLabel{},
+	&models.TaigaItemWatcher{},
+	&models.TaigaItemVoter{},
+	&models.TaigaIssueComment{},
+	&models.TaigaCustomAttribute{},
+	&models.TaigaCustomAttributeValue{},
+	&models.TaigaIssueChangelog{},
+	&models.TaigaTask{},
+	&models.TaigaStatus{},
+	&models.TaigaTaskMetric{},
+	&models.TaigaItemRelationship{},
+}
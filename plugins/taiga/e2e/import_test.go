@@ -0,0 +1,139 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package e2e
+
+import (
+	"encoding/json"
+	"os"
+	"slices"
+	"testing"
+
+	"github.com/apache/incubator-devlake/core/dal"
+	"github.com/apache/incubator-devlake/helpers/e2ehelper"
+	"github.com/irfanuddinahmad/taiga-devlake-plugin/plugins/taiga/impl"
+	"github.com/irfanuddinahmad/taiga-devlake-plugin/plugins/taiga/models"
+	"github.com/irfanuddinahmad/taiga-devlake-plugin/plugins/taiga/tasks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaigaProjectExportImport(t *testing.T) {
+	var taiga impl.Taiga
+	dataflowTester := e2ehelper.NewDataFlowTester(t, "taiga", taiga)
+
+	raw, readErr := os.ReadFile("fixtures/exports/archived_project.json")
+	require.NoError(t, readErr)
+	var export tasks.TaigaProjectExport
+	require.NoError(t, json.Unmarshal(raw, &export))
+
+	rawTables := []string{
+		tasks.RAW_PROJECT_TABLE, tasks.RAW_ACCOUNT_TABLE, tasks.RAW_MILESTONE_TABLE,
+		tasks.RAW_USER_STORY_TABLE, tasks.RAW_USER_STORY_HISTORY_TABLE,
+		tasks.RAW_TASK_STATUS_TABLE, tasks.RAW_TASK_TABLE, tasks.RAW_TASK_HISTORY_TABLE,
+	}
+	for _, table := range rawTables {
+		dataflowTester.FlushRawTable(table)
+	}
+	for _, table := range toolTables {
+		dataflowTester.FlushTabler(table)
+	}
+	dataflowTester.FlushTabler(&models.TaigaAccount{})
+
+	result, err := tasks.ImportProjectExport(dataflowTester.Dal, 1, 7, &export)
+	require.Nil(t, err)
+	assert.Equal(t, map[string]int{
+		tasks.RAW_PROJECT_TABLE:            1,
+		tasks.RAW_ACCOUNT_TABLE:            2,
+		tasks.RAW_MILESTONE_TABLE:          1,
+		tasks.RAW_USER_STORY_TABLE:         1,
+		tasks.RAW_USER_STORY_HISTORY_TABLE: 1,
+		tasks.RAW_TASK_STATUS_TABLE:        2,
+		tasks.RAW_TASK_TABLE:               1,
+		tasks.RAW_TASK_HISTORY_TABLE:       1,
+	}, result.RawRows)
+	assert.Equal(t, map[string]int{"issues": 1, "epics": 0}, result.Skipped)
+
+	// accounts are written under the params of the connection, where its catalog task reads them
+	countRaw := func(table string, params tasks.TaigaApiParams) int64 {
+		encoded, jsonErr := json.Marshal(params)
+		require.NoError(t, jsonErr)
+		count, countErr := dataflowTester.Dal.Count(dal.From("_raw_"+table), dal.Where("params = ?", string(encoded)))
+		require.NoError(t, countErr)
+		return count
+	}
+	assert.Equal(t, int64(2), countRaw(tasks.RAW_ACCOUNT_TABLE, tasks.TaigaApiParams{ConnectionId: 1}))
+	assert.Equal(t, int64(0), countRaw(tasks.RAW_ACCOUNT_TABLE, tasks.TaigaApiParams{ConnectionId: 1, ProjectId: 7}))
+	assert.Equal(t, int64(1), countRaw(tasks.RAW_TASK_TABLE, tasks.TaigaApiParams{ConnectionId: 1, ProjectId: 7}))
+
+	// importing again replaces the rows of the export, the ids don't depend on the order of the export
+	slices.Reverse(export.Memberships)
+	slices.Reverse(export.TaskStatuses)
+	_, err = tasks.ImportProjectExport(dataflowTester.Dal, 1, 7, &export)
+	require.Nil(t, err)
+	assert.Equal(t, int64(2), countRaw(tasks.RAW_ACCOUNT_TABLE, tasks.TaigaApiParams{ConnectionId: 1}))
+	assert.Equal(t, int64(1), countRaw(tasks.RAW_USER_STORY_TABLE, tasks.TaigaApiParams{ConnectionId: 1, ProjectId: 7}))
+
+	// the extractors replay the imported rows like the pipeline documented for imports does
+	connection := &models.TaigaConnection{}
+	connection.ID = 1
+	catalogData := &tasks.TaigaTaskData{
+		Options:    &tasks.TaigaOptions{ConnectionId: 1, ReplayRawData: true, ScopeConfig: &models.TaigaScopeConfig{}},
+		Connection: connection,
+	}
+	dataflowTester.Subtask(tasks.ExtractAccountsMeta, catalogData)
+	dataflowTester.VerifyTableWithOptions(models.TaigaAccount{}, e2ehelper.TableOptions{
+		CSVRelPath:   "./snapshot_tables/import_accounts.csv",
+		TargetFields: []string{"connection_id", "account_id", "username", "full_name", "email", "is_active"},
+	})
+
+	taskData := &tasks.TaigaTaskData{
+		Options:    &tasks.TaigaOptions{ConnectionId: 1, ProjectId: 7, ReplayRawData: true, ScopeConfig: &models.TaigaScopeConfig{}},
+		Connection: connection,
+	}
+	dataflowTester.Subtask(tasks.ExtractUserStoriesMeta, taskData)
+	dataflowTester.VerifyTableWithOptions(models.TaigaUserStory{}, e2ehelper.TableOptions{
+		CSVRelPath: "./snapshot_tables/import_user_stories.csv",
+		TargetFields: []string{
+			"connection_id", "project_id", "user_story_id", "ref", "subject", "status", "is_closed",
+			"assigned_to", "milestone_id", "total_watchers",
+		},
+	})
+	dataflowTester.Subtask(tasks.ExtractTaskStatusesMeta, taskData)
+	dataflowTester.Subtask(tasks.ExtractTasksMeta, taskData)
+	dataflowTester.VerifyTableWithOptions(models.TaigaTask{}, e2ehelper.TableOptions{
+		CSVRelPath: "./snapshot_tables/import_tasks.csv",
+		TargetFields: []string{
+			"connection_id", "task_id", "project_id", "user_story_id", "milestone_id", "ref", "subject", "status",
+			"is_closed", "assigned_to", "finished_date",
+		},
+	})
+	dataflowTester.Subtask(tasks.ExtractUserStoryHistoriesMeta, taskData)
+	dataflowTester.Subtask(tasks.ExtractTaskHistoriesMeta, taskData)
+	dataflowTester.VerifyTableWithOptions(models.TaigaIssueChangelog{}, e2ehelper.TableOptions{
+		CSVRelPath: "./snapshot_tables/import_issue_changelogs.csv",
+		TargetFields: []string{
+			"connection_id", "changelog_id", "field_name", "item_type", "item_id", "project_id", "author_id",
+			"from_value", "to_value", "created_date",
+		},
+	})
+
+	// the accounts of another project share the connection params without replacing these
+	_, err = tasks.ImportProjectExport(dataflowTester.Dal, 1, 8, &export)
+	require.Nil(t, err)
+	assert.Equal(t, int64(4), countRaw(tasks.RAW_ACCOUNT_TABLE, tasks.TaigaApiParams{ConnectionId: 1}))
+}
@@ -0,0 +1,75 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package e2e
+
+import (
+	"testing"
+
+	"github.com/apache/incubator-devlake/core/models/common"
+	"github.com/apache/incubator-devlake/core/models/domainlayer/ticket"
+	"github.com/apache/incubator-devlake/helpers/e2ehelper"
+	"github.com/irfanuddinahmad/taiga-devlake-plugin/plugins/taiga/impl"
+	"github.com/irfanuddinahmad/taiga-devlake-plugin/plugins/taiga/models"
+	"github.com/irfanuddinahmad/taiga-devlake-plugin/plugins/taiga/tasks"
+)
+
+func TestTaigaIssueRelationshipDataFlow(t *testing.T) {
+	var taiga impl.Taiga
+	dataflowTester := e2ehelper.NewDataFlowTester(t, "taiga", taiga)
+	fake := newTaigaFake(t)
+	taskData := newFakeTaskData(t, dataflowTester, fake, fakeToken)
+	// task 202 is marked as a duplicate of task 201 by its ref, #3
+	taskData.Options.ScopeConfig.CustomAttributeMappings = map[string]models.CustomAttributeMapping{
+		models.IssueFieldDuplicateOf: {Attribute: "Duplicate of"},
+	}
+
+	// collect and extract
+	dataflowTester.FlushRawTable(tasks.RAW_USER_STORY_TABLE)
+	dataflowTester.FlushRawTable(tasks.RAW_TASK_TABLE)
+	dataflowTester.FlushRawTable(tasks.RAW_CUSTOM_ATTRIBUTE_TABLE)
+	dataflowTester.FlushRawTable(tasks.RAW_CUSTOM_ATTRIBUTE_VALUE_TABLE)
+	dataflowTester.FlushRawTable(tasks.RAW_TASK_CUSTOM_ATTRIBUTE_VALUE_TABLE)
+	for _, table := range toolTables {
+		dataflowTester.FlushTabler(table)
+	}
+	dataflowTester.Subtask(tasks.CollectUserStoriesMeta, taskData)
+	dataflowTester.Subtask(tasks.ExtractUserStoriesMeta, taskData)
+	dataflowTester.Subtask(tasks.CollectTasksMeta, taskData)
+	dataflowTester.Subtask(tasks.ExtractTasksMeta, taskData)
+	dataflowTester.Subtask(tasks.CollectCustomAttributesMeta, taskData)
+	dataflowTester.Subtask(tasks.ExtractCustomAttributesMeta, taskData)
+	dataflowTester.Subtask(tasks.CollectCustomAttributeValuesMeta, taskData)
+	dataflowTester.Subtask(tasks.ExtractCustomAttributeValuesMeta, taskData)
+	dataflowTester.Subtask(tasks.CollectTaskCustomAttributeValuesMeta, taskData)
+	dataflowTester.Subtask(tasks.ExtractTaskCustomAttributeValuesMeta, taskData)
+	dataflowTester.VerifyTableWithOptions(models.TaigaItemRelationship{}, e2ehelper.TableOptions{
+		CSVRelPath: "./snapshot_tables/_tool_taiga_item_relationships.csv",
+		TargetFields: []string{
+			"connection_id", "source_item_type", "source_item_id", "target_item_type", "target_item_id",
+			"relationship_type", "project_id",
+		},
+	})
+
+	// convert, story 102 was promoted from issue 301, which is not converted, so the link stays in the tool layer
+	dataflowTester.FlushTabler(&ticket.IssueRelationship{})
+	dataflowTester.Subtask(tasks.ConvertIssueRelationshipsMeta, taskData)
+	dataflowTester.VerifyTableWithOptions(ticket.IssueRelationship{}, e2ehelper.TableOptions{
+		CSVRelPath:  "./snapshot_tables/issue_relationships.csv",
+		IgnoreTypes: []interface{}{common.NoPKModel{}},
+	})
+}
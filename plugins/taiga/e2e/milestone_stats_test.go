@@ -0,0 +1,56 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package e2e
+
+import (
+	"testing"
+
+	"github.com/apache/incubator-devlake/helpers/e2ehelper"
+	"github.com/irfanuddinahmad/taiga-devlake-plugin/plugins/taiga/impl"
+	"github.com/irfanuddinahmad/taiga-devlake-plugin/plugins/taiga/models"
+	"github.com/irfanuddinahmad/taiga-devlake-plugin/plugins/taiga/tasks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTaigaMilestoneStatsDataFlow(t *testing.T) {
+	var taiga impl.Taiga
+	dataflowTester := e2ehelper.NewDataFlowTester(t, "taiga", taiga)
+	fake := newTaigaFake(t)
+	taskData := newFakeTaskData(t, dataflowTester, fake, fakeToken)
+
+	dataflowTester.FlushRawTable(tasks.RAW_MILESTONE_TABLE)
+	dataflowTester.FlushRawTable(tasks.RAW_MILESTONE_STATS_TABLE)
+	dataflowTester.FlushTabler(&models.TaigaMilestone{})
+	dataflowTester.FlushTabler(&models.TaigaMilestoneBurndown{})
+	dataflowTester.Subtask(tasks.CollectMilestonesMeta, taskData)
+	dataflowTester.Subtask(tasks.ExtractMilestonesMeta, taskData)
+
+	// sprint 0 was closed long ago, only the stats of the open sprint are requested
+	dataflowTester.Subtask(tasks.CollectMilestoneStatsMeta, taskData)
+	assert.Equal(t, 1, fake.requestCount("milestones/31/stats"))
+	assert.Equal(t, 0, fake.requestCount("milestones/32/stats"))
+
+	// the day without open points is still ahead, it only has its optimal points
+	dataflowTester.Subtask(tasks.ExtractMilestoneStatsMeta, taskData)
+	dataflowTester.VerifyTableWithOptions(models.TaigaMilestoneBurndown{}, e2ehelper.TableOptions{
+		CSVRelPath: "./snapshot_tables/_tool_taiga_milestone_burndowns.csv",
+		TargetFields: []string{
+			"connection_id", "milestone_id", "day", "project_id", "total_points", "open_points", "completed_points", "optimal_points",
+		},
+	})
+}
@@ -0,0 +1,59 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package e2e
+
+import (
+	"testing"
+
+	"github.com/apache/incubator-devlake/core/models/common"
+	"github.com/apache/incubator-devlake/core/models/domainlayer/ticket"
+	"github.com/apache/incubator-devlake/helpers/e2ehelper"
+	"github.com/irfanuddinahmad/taiga-devlake-plugin/plugins/taiga/impl"
+	"github.com/irfanuddinahmad/taiga-devlake-plugin/plugins/taiga/models"
+	"github.com/irfanuddinahmad/taiga-devlake-plugin/plugins/taiga/tasks"
+)
+
+func TestTaigaProjectDataFlow(t *testing.T) {
+	var taiga impl.Taiga
+	dataflowTester := e2ehelper.NewDataFlowTester(t, "taiga", taiga)
+	fake := newTaigaFake(t)
+	taskData := newFakeTaskData(t, dataflowTester, fake, fakeToken)
+
+	// collect and extract
+	dataflowTester.FlushRawTable(tasks.RAW_PROJECT_TABLE)
+	for _, table := range toolTables {
+		dataflowTester.FlushTabler(table)
+	}
+	dataflowTester.Subtask(tasks.CollectProjectsMeta, taskData)
+	dataflowTester.Subtask(tasks.ExtractProjectsMeta, taskData)
+	dataflowTester.VerifyTableWithOptions(models.TaigaProject{}, e2ehelper.TableOptions{
+		CSVRelPath: "./snapshot_tables/_tool_taiga_projects.csv",
+		TargetFields: []string{
+			"connection_id", "project_id", "name", "slug", "description", "is_backlog_activated", "is_kanban_activated",
+		},
+	})
+
+	// convert
+	dataflowTester.FlushTabler(&ticket.Board{})
+	dataflowTester.Subtask(tasks.ConvertProjectsMeta, taskData)
+	dataflowTester.VerifyTableWithOptions(ticket.Board{}, e2ehelper.TableOptions{
+		CSVRelPath:   "./snapshot_tables/boards.csv",
+		TargetFields: []string{"id", "name", "description", "type"},
+		IgnoreTypes:  []interface{}{common.NoPKModel{}},
+	})
+}
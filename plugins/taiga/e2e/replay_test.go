@@ -0,0 +1,61 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package e2e
+
+import (
+	"testing"
+
+	"github.com/apache/incubator-devlake/core/dal"
+	"github.com/apache/incubator-devlake/helpers/e2ehelper"
+	"github.com/irfanuddinahmad/taiga-devlake-plugin/plugins/taiga/impl"
+	"github.com/irfanuddinahmad/taiga-devlake-plugin/plugins/taiga/models"
+	"github.com/irfanuddinahmad/taiga-devlake-plugin/plugins/taiga/tasks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaigaReplayRawData(t *testing.T) {
+	var taiga impl.Taiga
+	dataflowTester := e2ehelper.NewDataFlowTester(t, "taiga", taiga)
+	fake := newTaigaFake(t)
+	taskData := newFakeTaskData(t, dataflowTester, fake, fakeToken)
+
+	dataflowTester.FlushRawTable(tasks.RAW_USER_STORY_TABLE)
+	for _, table := range toolTables {
+		dataflowTester.FlushTabler(table)
+	}
+	dataflowTester.Subtask(tasks.CollectUserStoriesMeta, taskData)
+
+	// replaying neither needs an api client nor touches the collected raw rows
+	taskData.ApiClient = nil
+	taskData.Options.ReplayRawData = true
+	dataflowTester.Subtask(tasks.CollectUserStoriesMeta, taskData)
+	dataflowTester.Subtask(tasks.ExtractUserStoriesMeta, taskData)
+	assert.Equal(t, 1, fake.requestCount("userstories"))
+
+	count, err := dataflowTester.Dal.Count(dal.From("_raw_" + tasks.RAW_USER_STORY_TABLE))
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), count)
+	dataflowTester.VerifyTableWithOptions(models.TaigaUserStory{}, e2ehelper.TableOptions{
+		CSVRelPath: "./snapshot_tables/_tool_taiga_user_stories.csv",
+		TargetFields: []string{
+			"connection_id", "project_id", "user_story_id", "ref", "subject", "status", "is_closed",
+			"due_date", "due_date_reason", "assigned_to", "total_points", "total_watchers",
+		},
+	})
+}
@@ -0,0 +1,70 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package e2e
+
+import (
+	"testing"
+
+	"github.com/apache/incubator-devlake/core/dal"
+	"github.com/apache/incubator-devlake/core/models/domainlayer/ticket"
+	"github.com/apache/incubator-devlake/helpers/e2ehelper"
+	"github.com/irfanuddinahmad/taiga-devlake-plugin/plugins/taiga/impl"
+	"github.com/irfanuddinahmad/taiga-devlake-plugin/plugins/taiga/models"
+	"github.com/irfanuddinahmad/taiga-devlake-plugin/plugins/taiga/tasks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaigaRolePointDataFlow(t *testing.T) {
+	var taiga impl.Taiga
+	dataflowTester := e2ehelper.NewDataFlowTester(t, "taiga", taiga)
+	fake := newTaigaFake(t)
+	taskData := newFakeTaskData(t, dataflowTester, fake, fakeToken)
+
+	// the roles and points come with the project, the estimates with the user stories
+	dataflowTester.FlushRawTable(tasks.RAW_PROJECT_TABLE)
+	dataflowTester.FlushRawTable(tasks.RAW_USER_STORY_TABLE)
+	for _, table := range toolTables {
+		dataflowTester.FlushTabler(table)
+	}
+	dataflowTester.Subtask(tasks.CollectProjectsMeta, taskData)
+	dataflowTester.Subtask(tasks.ExtractProjectsMeta, taskData)
+	dataflowTester.Subtask(tasks.CollectUserStoriesMeta, taskData)
+	dataflowTester.Subtask(tasks.ExtractUserStoriesMeta, taskData)
+	dataflowTester.VerifyTableWithOptions(models.TaigaUserStoryRolePoint{}, e2ehelper.TableOptions{
+		CSVRelPath:   "./snapshot_tables/_tool_taiga_user_story_role_points.csv",
+		TargetFields: []string{"connection_id", "user_story_id", "role_id", "project_id", "point_id"},
+	})
+
+	// the estimate of the configured role becomes the story point, the role name is case insensitive
+	taskData.Options.ScopeConfig.StoryPointRole = "back"
+	dataflowTester.FlushTabler(&ticket.Issue{})
+	dataflowTester.FlushTabler(&ticket.BoardIssue{})
+	dataflowTester.FlushTabler(&ticket.IssueWorklog{})
+	dataflowTester.Subtask(tasks.ConvertUserStoriesMeta, taskData)
+
+	var estimated ticket.Issue
+	require.NoError(t, dataflowTester.Dal.First(&estimated, dal.Where("id = ?", "taiga:TaigaUserStory:1:101")))
+	require.NotNil(t, estimated.StoryPoint)
+	assert.Equal(t, 3.0, *estimated.StoryPoint)
+
+	// a story the role has not estimated has no story point
+	var unestimated ticket.Issue
+	require.NoError(t, dataflowTester.Dal.First(&unestimated, dal.Where("id = ?", "taiga:TaigaUserStory:1:102")))
+	assert.Nil(t, unestimated.StoryPoint)
+}
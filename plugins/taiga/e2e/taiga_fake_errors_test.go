@@ -0,0 +1,91 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package e2e
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/apache/incubator-devlake/core/dal"
+	"github.com/apache/incubator-devlake/helpers/e2ehelper"
+	helper "github.com/apache/incubator-devlake/helpers/pluginhelper/api"
+	"github.com/irfanuddinahmad/taiga-devlake-plugin/plugins/taiga/impl"
+	"github.com/irfanuddinahmad/taiga-devlake-plugin/plugins/taiga/tasks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaigaFakeRejectsInvalidToken(t *testing.T) {
+	var taiga impl.Taiga
+	dataflowTester := e2ehelper.NewDataFlowTester(t, "taiga", taiga)
+	fake := newTaigaFake(t)
+	taskData := newFakeTaskData(t, dataflowTester, fake, "expired-token")
+
+	dataflowTester.FlushRawTable(tasks.RAW_PROJECT_TABLE)
+	err := tasks.CollectProjects(dataflowTester.SubtaskContext(taskData))
+	assert.Error(t, err)
+}
+
+func TestTaigaFakeUnknownProject(t *testing.T) {
+	var taiga impl.Taiga
+	dataflowTester := e2ehelper.NewDataFlowTester(t, "taiga", taiga)
+	fake := newTaigaFake(t)
+	taskData := newFakeTaskData(t, dataflowTester, fake, fakeToken)
+	taskData.Options.ProjectId = 999
+
+	dataflowTester.FlushRawTable(tasks.RAW_PROJECT_TABLE)
+	err := tasks.CollectProjects(dataflowTester.SubtaskContext(taskData))
+	assert.Error(t, err)
+	assert.Equal(t, 1, fake.requestCount("projects/999"))
+}
+
+func TestTaigaFakeThrottledCollectionIsRetried(t *testing.T) {
+	var taiga impl.Taiga
+	dataflowTester := e2ehelper.NewDataFlowTester(t, "taiga", taiga)
+	fake := newTaigaFake(t)
+	taskData := newFakeTaskData(t, dataflowTester, fake, fakeToken)
+	fake.throttle("userstories", 1)
+
+	dataflowTester.FlushRawTable(tasks.RAW_USER_STORY_TABLE)
+	dataflowTester.Subtask(tasks.CollectUserStoriesMeta, taskData)
+	assert.Equal(t, 2, fake.requestCount("userstories"))
+	count, err := dataflowTester.Dal.Count(dal.From("_raw_" + tasks.RAW_USER_STORY_TABLE))
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), count)
+}
+
+func TestTaigaFakePagination(t *testing.T) {
+	var taiga impl.Taiga
+	dataflowTester := e2ehelper.NewDataFlowTester(t, "taiga", taiga)
+	fake := newTaigaFake(t)
+	taskData := newFakeTaskData(t, dataflowTester, fake, fakeToken)
+
+	query := url.Values{"project": {"1"}, "page": {"2"}, "page_size": {"2"}}
+	res, err := taskData.ApiClient.Get("tasks", query, nil)
+	require.NoError(t, err)
+	defer res.Body.Close()
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+	assert.Equal(t, "3", res.Header.Get("X-Pagination-Count"))
+	assert.Equal(t, "2", res.Header.Get("X-Pagination-Current"))
+	assert.Empty(t, res.Header.Get("X-Pagination-Next"))
+	var page []map[string]interface{}
+	require.NoError(t, helper.UnmarshalResponse(res, &page))
+	require.Len(t, page, 1)
+	assert.Equal(t, float64(203), page[0]["id"])
+}
@@ -0,0 +1,192 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package e2e
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+const fakeToken = "taiga-fake-token"
+
+// taigaFake is an in-process Taiga API serving the JSON files under fixtures/. A request to
+// api/v1/<path> is answered with fixtures/<path>.json, or fixtures/<path>/project_<id>.json when
+// filtered by project. Like Taiga it checks the bearer token, applies modified_date__gte, paginates
+// lists when a page is asked for and answers 404 for anything it has no fixture for. Lists of
+// attachments are filtered by object_id.
+type taigaFake struct {
+	*httptest.Server
+	mu sync.Mutex
+	// number of 429 responses still to send per path
+	throttled map[string]int
+	// number of requests received per path
+	requests map[string]int
+}
+
+func newTaigaFake(t *testing.T) *taigaFake {
+	fake := &taigaFake{
+		throttled: make(map[string]int),
+		requests:  make(map[string]int),
+	}
+	fake.Server = httptest.NewServer(http.HandlerFunc(fake.serve))
+	t.Cleanup(fake.Close)
+	return fake
+}
+
+// throttle makes the next requests to path fail with 429 Too Many Requests
+func (f *taigaFake) throttle(path string, times int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.throttled[path] = times
+}
+
+func (f *taigaFake) requestCount(path string) int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.requests[path]
+}
+
+func (f *taigaFake) serve(w http.ResponseWriter, r *http.Request) {
+	path := strings.Trim(strings.TrimPrefix(r.URL.Path, "/api/v1/"), "/")
+	f.mu.Lock()
+	f.requests[path]++
+	throttled := f.throttled[path] > 0
+	if throttled {
+		f.throttled[path]--
+	}
+	f.mu.Unlock()
+
+	if r.Header.Get("Authorization") != "Bearer "+fakeToken {
+		writeTaigaError(w, http.StatusUnauthorized, "Invalid token")
+		return
+	}
+	if throttled {
+		w.Header().Set("Retry-After", "0")
+		writeTaigaError(w, http.StatusTooManyRequests, "Request was throttled.")
+		return
+	}
+
+	fixture := filepath.Join("fixtures", filepath.FromSlash(path)+".json")
+	if project := r.URL.Query().Get("project"); project != "" {
+		fixture = filepath.Join("fixtures", filepath.FromSlash(path), "project_"+project+".json")
+	}
+	body, err := os.ReadFile(fixture)
+	if err != nil {
+		writeTaigaError(w, http.StatusNotFound, "No matches the given query.")
+		return
+	}
+
+	var items []json.RawMessage
+	if json.Unmarshal(body, &items) == nil {
+		if since := r.URL.Query().Get("modified_date__gte"); since != "" {
+			items = modifiedSince(items, since)
+			body, _ = json.Marshal(items)
+		}
+		if objectId := r.URL.Query().Get("object_id"); objectId != "" {
+			items = ofObject(items, objectId)
+			body, _ = json.Marshal(items)
+		}
+		w.Header().Set("X-Pagination-Count", strconv.Itoa(len(items)))
+		page := r.URL.Query().Get("page")
+		if page != "" && !strings.EqualFold(r.Header.Get("X-Disable-Pagination"), "true") {
+			body, err = f.paginate(w, r, items, page)
+			if err != nil {
+				writeTaigaError(w, http.StatusNotFound, err.Error())
+				return
+			}
+		} else {
+			w.Header().Set("X-Paginated", "false")
+		}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(body)
+}
+
+// paginate cuts one page out of a list the way Taiga does, 30 items per page by default
+func (f *taigaFake) paginate(w http.ResponseWriter, r *http.Request, items []json.RawMessage, page string) ([]byte, error) {
+	pageSize := 30
+	if size, err := strconv.Atoi(r.URL.Query().Get("page_size")); err == nil && size > 0 {
+		pageSize = size
+	}
+	current, err := strconv.Atoi(page)
+	if err != nil || current < 1 || (current > 1 && (current-1)*pageSize >= len(items)) {
+		return nil, errors.New("Invalid page.")
+	}
+	end := current * pageSize
+	if end > len(items) {
+		end = len(items)
+	}
+	w.Header().Set("X-Paginated", "true")
+	w.Header().Set("X-Paginated-By", strconv.Itoa(pageSize))
+	w.Header().Set("X-Pagination-Current", strconv.Itoa(current))
+	if end < len(items) {
+		next := *r.URL
+		query := next.Query()
+		query.Set("page", strconv.Itoa(current+1))
+		next.RawQuery = query.Encode()
+		w.Header().Set("X-Pagination-Next", f.URL+next.String())
+	}
+	return json.Marshal(items[(current-1)*pageSize : end])
+}
+
+// modifiedSince keeps the items whose modified_date is not before since, both being RFC 3339 times
+func modifiedSince(items []json.RawMessage, since string) []json.RawMessage {
+	sinceTime, err := time.Parse(time.RFC3339, since)
+	if err != nil {
+		return items
+	}
+	kept := make([]json.RawMessage, 0, len(items))
+	for _, item := range items {
+		var dates struct {
+			ModifiedDate time.Time `json:"modified_date"`
+		}
+		if json.Unmarshal(item, &dates) == nil && !dates.ModifiedDate.Before(sinceTime) {
+			kept = append(kept, item)
+		}
+	}
+	return kept
+}
+
+// ofObject keeps the items, e.g. attachments, whose object_id is objectId
+func ofObject(items []json.RawMessage, objectId string) []json.RawMessage {
+	kept := make([]json.RawMessage, 0, len(items))
+	for _, item := range items {
+		var object struct {
+			ObjectId json.Number `json:"object_id"`
+		}
+		if json.Unmarshal(item, &object) == nil && object.ObjectId.String() == objectId {
+			kept = append(kept, item)
+		}
+	}
+	return kept
+}
+
+func writeTaigaError(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(map[string]string{"_error_message": message, "_error_type": http.StatusText(status)})
+}
@@ -0,0 +1,133 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package e2e
+
+import (
+	"testing"
+
+	"github.com/apache/incubator-devlake/core/dal"
+	"github.com/apache/incubator-devlake/core/models/common"
+	"github.com/apache/incubator-devlake/core/models/domainlayer/ticket"
+	"github.com/apache/incubator-devlake/helpers/e2ehelper"
+	"github.com/irfanuddinahmad/taiga-devlake-plugin/plugins/taiga/impl"
+	"github.com/irfanuddinahmad/taiga-devlake-plugin/plugins/taiga/models"
+	"github.com/irfanuddinahmad/taiga-devlake-plugin/plugins/taiga/tasks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaigaTaskDataFlow(t *testing.T) {
+	var taiga impl.Taiga
+	dataflowTester := e2ehelper.NewDataFlowTester(t, "taiga", taiga)
+	fake := newTaigaFake(t)
+	taskData := newFakeTaskData(t, dataflowTester, fake, fakeToken)
+
+	// collect and extract
+	dataflowTester.FlushRawTable(tasks.RAW_TASK_TABLE)
+	dataflowTester.FlushRawTable(tasks.RAW_TASK_STATUS_TABLE)
+	dataflowTester.FlushRawTable(tasks.RAW_TASK_HISTORY_TABLE)
+	for _, table := range toolTables {
+		dataflowTester.FlushTabler(table)
+	}
+	dataflowTester.Subtask(tasks.CollectTaskStatusesMeta, taskData)
+	dataflowTester.Subtask(tasks.ExtractTaskStatusesMeta, taskData)
+	dataflowTester.Subtask(tasks.CollectTasksMeta, taskData)
+	dataflowTester.Subtask(tasks.ExtractTasksMeta, taskData)
+	dataflowTester.Subtask(tasks.CollectTaskHistoriesMeta, taskData)
+	dataflowTester.Subtask(tasks.ExtractTaskHistoriesMeta, taskData)
+
+	// "Ready for test" comes after the first status of the workflow, so it counts as in progress
+	dataflowTester.Subtask(tasks.CalculateTaskMetricsMeta, taskData)
+	dataflowTester.VerifyTableWithOptions(models.TaigaTaskMetric{}, e2ehelper.TableOptions{
+		CSVRelPath: "./snapshot_tables/_tool_taiga_task_metrics.csv",
+		TargetFields: []string{
+			"connection_id", "task_id", "project_id", "assigned_to", "in_progress_date", "closed_date", "cycle_time_minutes",
+		},
+	})
+
+	// convert
+	dataflowTester.FlushTabler(&ticket.Issue{})
+	dataflowTester.FlushTabler(&ticket.BoardIssue{})
+	dataflowTester.Subtask(tasks.ConvertTasksMeta, taskData)
+	dataflowTester.VerifyTableWithOptions(ticket.Issue{}, e2ehelper.TableOptions{
+		CSVRelPath: "./snapshot_tables/task_issues.csv",
+		TargetFields: []string{
+			"id", "type", "status", "original_status", "parent_issue_id", "assignee_id", "lead_time_minutes",
+		},
+		IgnoreTypes: []interface{}{common.NoPKModel{}},
+	})
+
+	// the tags of tasks become labels of their sub-task issues
+	dataflowTester.VerifyTableWithOptions(models.TaigaIssueLabel{}, e2ehelper.TableOptions{
+		CSVRelPath:   "./snapshot_tables/_tool_taiga_task_labels.csv",
+		TargetFields: []string{"connection_id", "item_type", "item_id", "label_name", "project_id", "color"},
+	})
+	dataflowTester.FlushTabler(&ticket.IssueLabel{})
+	dataflowTester.Subtask(tasks.ConvertIssueLabelsMeta, taskData)
+	dataflowTester.VerifyTableWithOptions(ticket.IssueLabel{}, e2ehelper.TableOptions{
+		CSVRelPath:  "./snapshot_tables/task_issue_labels.csv",
+		IgnoreTypes: []interface{}{common.NoPKModel{}},
+	})
+
+	// the tag rules of the scope config name the type of a single task
+	taskData.Options.ScopeConfig.IssueTypeBug = "backend"
+	dataflowTester.Subtask(tasks.ConvertTasksMeta, taskData)
+	var tagged ticket.Issue
+	require.NoError(t, dataflowTester.Dal.First(&tagged, dal.Where("id = ?", "taiga:TaigaTask:1:201")))
+	assert.Equal(t, ticket.BUG, tagged.Type)
+	var untagged ticket.Issue
+	require.NoError(t, dataflowTester.Dal.First(&untagged, dal.Where("id = ?", "taiga:TaigaTask:1:202")))
+	assert.Equal(t, "SUBTASK", untagged.Type)
+	taskData.Options.ScopeConfig.IssueTypeBug = ""
+
+	// comments of tasks are read from their history like the ones of user stories
+	dataflowTester.FlushTabler(&ticket.IssueComment{})
+	dataflowTester.Subtask(tasks.ConvertIssueCommentsMeta, taskData)
+	dataflowTester.VerifyTableWithOptions(ticket.IssueComment{}, e2ehelper.TableOptions{
+		CSVRelPath:   "./snapshot_tables/task_issue_comments.csv",
+		TargetFields: []string{"id", "issue_id", "body", "account_id", "created_date"},
+		IgnoreTypes:  []interface{}{common.NoPKModel{}},
+	})
+
+	// watchers of tasks become custom array fields of their sub-task issues
+	dataflowTester.FlushTabler(&ticket.IssueCustomArrayField{})
+	dataflowTester.Subtask(tasks.ConvertEngagementsMeta, taskData)
+	dataflowTester.VerifyTableWithOptions(ticket.IssueCustomArrayField{}, e2ehelper.TableOptions{
+		CSVRelPath:  "./snapshot_tables/task_issue_custom_array_fields.csv",
+		IgnoreTypes: []interface{}{common.NoPKModel{}},
+	})
+
+	// the field changes of tasks become changelogs of their sub-task issues
+	dataflowTester.FlushTabler(&ticket.IssueChangelogs{})
+	dataflowTester.Subtask(tasks.ConvertIssueChangelogsMeta, taskData)
+	dataflowTester.VerifyTableWithOptions(ticket.IssueChangelogs{}, e2ehelper.TableOptions{
+		CSVRelPath:   "./snapshot_tables/task_issue_changelogs.csv",
+		TargetFields: []string{"id", "issue_id", "author_id", "field_name", "original_from_value", "original_to_value", "created_date"},
+		IgnoreTypes:  []interface{}{common.NoPKModel{}},
+	})
+
+	// the status mappings of the scope config win over the catalog
+	taskData.Options.ScopeConfig.TypeMappings = map[string]models.TypeMapping{
+		models.ItemTypeTask: {StatusMappings: models.StatusMappings{"Ready for test": {StandardStatus: ticket.TODO}}},
+	}
+	dataflowTester.Subtask(tasks.CalculateTaskMetricsMeta, taskData)
+	var metric models.TaigaTaskMetric
+	require.NoError(t, dataflowTester.Dal.First(&metric, dal.Where("connection_id = ? AND task_id = ?", 1, 203)))
+	assert.Nil(t, metric.InProgressDate)
+	assert.Nil(t, metric.CycleTimeMinutes)
+}
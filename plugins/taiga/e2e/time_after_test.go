@@ -0,0 +1,76 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package e2e
+
+import (
+	"testing"
+	"time"
+
+	"github.com/apache/incubator-devlake/core/dal"
+	"github.com/apache/incubator-devlake/core/models"
+	"github.com/apache/incubator-devlake/core/models/domainlayer/ticket"
+	"github.com/apache/incubator-devlake/helpers/e2ehelper"
+	"github.com/irfanuddinahmad/taiga-devlake-plugin/plugins/taiga/impl"
+	taigaModels "github.com/irfanuddinahmad/taiga-devlake-plugin/plugins/taiga/models"
+	"github.com/irfanuddinahmad/taiga-devlake-plugin/plugins/taiga/tasks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaigaTimeAfter(t *testing.T) {
+	var taiga impl.Taiga
+	dataflowTester := e2ehelper.NewDataFlowTester(t, "taiga", taiga)
+	fake := newTaigaFake(t)
+	taskData := newFakeTaskData(t, dataflowTester, fake, fakeToken)
+
+	dataflowTester.FlushRawTable(tasks.RAW_USER_STORY_TABLE)
+	for _, table := range toolTables {
+		dataflowTester.FlushTabler(table)
+	}
+	dataflowTester.Subtask(tasks.CollectUserStoriesMeta, taskData)
+	dataflowTester.Subtask(tasks.ExtractUserStoriesMeta, taskData)
+
+	// story 101 was last modified on 2024-01-06, story 102 on 2024-01-20
+	timeAfter := time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC)
+	taskData.TimeAfter = &timeAfter
+
+	// the convertor leaves out the older tool rows of the earlier run
+	dataflowTester.FlushTabler(&models.SubtaskState{})
+	dataflowTester.FlushTabler(&ticket.Issue{})
+	dataflowTester.FlushTabler(&ticket.BoardIssue{})
+	dataflowTester.Subtask(tasks.ConvertUserStoriesMeta, taskData)
+	var issues []ticket.Issue
+	require.NoError(t, dataflowTester.Dal.All(&issues))
+	require.Len(t, issues, 1)
+	assert.Equal(t, "taiga:TaigaUserStory:1:102", issues[0].Id)
+
+	// the labels follow their stories, the tag of story 101 is left out
+	dataflowTester.FlushTabler(&ticket.IssueLabel{})
+	dataflowTester.Subtask(tasks.ConvertIssueLabelsMeta, taskData)
+	var labels []ticket.IssueLabel
+	require.NoError(t, dataflowTester.Dal.All(&labels))
+	assert.Empty(t, labels)
+
+	// the collector asks Taiga for the recent stories only
+	dataflowTester.Subtask(tasks.CollectUserStoriesMeta, taskData)
+	dataflowTester.Subtask(tasks.ExtractUserStoriesMeta, taskData)
+	var stories []taigaModels.TaigaUserStory
+	require.NoError(t, dataflowTester.Dal.All(&stories, dal.Where("project_id = ?", 1)))
+	require.Len(t, stories, 1)
+	assert.Equal(t, uint64(102), stories[0].UserStoryId)
+}
@@ -0,0 +1,79 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package e2e
+
+import (
+	"testing"
+
+	"github.com/apache/incubator-devlake/core/models/common"
+	"github.com/apache/incubator-devlake/helpers/e2ehelper"
+	"github.com/irfanuddinahmad/taiga-devlake-plugin/plugins/taiga/impl"
+	"github.com/irfanuddinahmad/taiga-devlake-plugin/plugins/taiga/models"
+	"github.com/irfanuddinahmad/taiga-devlake-plugin/plugins/taiga/tasks"
+)
+
+func TestTaigaWikiDataFlow(t *testing.T) {
+	var taiga impl.Taiga
+	dataflowTester := e2ehelper.NewDataFlowTester(t, "taiga", taiga)
+	fake := newTaigaFake(t)
+	taskData := newFakeTaskData(t, dataflowTester, fake, fakeToken)
+
+	// collect and extract
+	dataflowTester.FlushRawTable(tasks.RAW_WIKI_PAGE_TABLE)
+	dataflowTester.FlushRawTable(tasks.RAW_WIKI_LINK_TABLE)
+	dataflowTester.FlushRawTable(tasks.RAW_WIKI_HISTORY_TABLE)
+	dataflowTester.FlushTabler(&models.TaigaWikiPage{})
+	dataflowTester.FlushTabler(&models.TaigaWikiLink{})
+	dataflowTester.FlushTabler(&models.TaigaWikiPageEdit{})
+	dataflowTester.Subtask(tasks.CollectWikiPagesMeta, taskData)
+	dataflowTester.Subtask(tasks.ExtractWikiPagesMeta, taskData)
+	dataflowTester.VerifyTableWithOptions(models.TaigaWikiPage{}, e2ehelper.TableOptions{
+		CSVRelPath: "./snapshot_tables/_tool_taiga_wiki_pages.csv",
+		TargetFields: []string{
+			"connection_id", "wiki_page_id", "project_id", "slug", "version", "editions",
+			"owner_id", "last_modifier_id", "created_date", "modified_date",
+		},
+	})
+	dataflowTester.Subtask(tasks.CollectWikiLinksMeta, taskData)
+	dataflowTester.Subtask(tasks.ExtractWikiLinksMeta, taskData)
+	dataflowTester.VerifyTableWithOptions(models.TaigaWikiLink{}, e2ehelper.TableOptions{
+		CSVRelPath:   "./snapshot_tables/_tool_taiga_wiki_links.csv",
+		TargetFields: []string{"connection_id", "wiki_link_id", "project_id", "title", "href", "order"},
+	})
+
+	// the history of every page is requested page by page, entries other than creations and changes are dropped
+	dataflowTester.Subtask(tasks.CollectWikiHistoriesMeta, taskData)
+	dataflowTester.Subtask(tasks.ExtractWikiHistoriesMeta, taskData)
+	dataflowTester.VerifyTableWithOptions(models.TaigaWikiPageEdit{}, e2ehelper.TableOptions{
+		CSVRelPath: "./snapshot_tables/_tool_taiga_wiki_page_edits.csv",
+		TargetFields: []string{
+			"connection_id", "edit_id", "wiki_page_id", "project_id", "author_id", "author_name", "edit_type", "created_date",
+		},
+	})
+
+	// convert, pages are named after their wiki link
+	dataflowTester.FlushTabler(&models.TaigaDocumentationActivity{})
+	dataflowTester.Subtask(tasks.ConvertDocumentationActivitiesMeta, taskData)
+	dataflowTester.VerifyTableWithOptions(models.TaigaDocumentationActivity{}, e2ehelper.TableOptions{
+		CSVRelPath: "./snapshot_tables/_tool_taiga_documentation_activities.csv",
+		TargetFields: []string{
+			"id", "board_id", "page_id", "page_title", "author_id", "author_name", "activity_type", "created_date",
+		},
+		IgnoreTypes: []interface{}{common.NoPKModel{}},
+	})
+}
@@ -19,6 +19,7 @@ package impl
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/apache/incubator-devlake/core/context"
 	"github.com/apache/incubator-devlake/core/dal"
@@ -69,6 +70,30 @@ func (p Taiga) GetTablesInfo() []dal.Tabler {
 		&models.TaigaProject{},
 		&models.TaigaUserStory{},
 		&models.TaigaScopeConfig{},
+		&models.TaigaCustomAttribute{},
+		&models.TaigaCustomAttributeValue{},
+		&models.TaigaRole{},
+		&models.TaigaPoint{},
+		&models.TaigaUserStoryRolePoint{},
+		&models.TaigaIssueLabel{},
+		&models.TaigaIssueComment{},
+		&models.TaigaAccount{},
+		&models.TaigaItemWatcher{},
+		&models.TaigaItemVoter{},
+		&models.TaigaAttachment{},
+		&models.TaigaIssueChangelog{},
+		&models.TaigaBlockedPeriod{},
+		&models.TaigaWikiPage{},
+		&models.TaigaWikiLink{},
+		&models.TaigaWikiPageEdit{},
+		&models.TaigaDocumentationActivity{},
+		&models.TaigaMilestone{},
+		&models.TaigaMilestoneBurndown{},
+		&models.TaigaProjectStatsSnapshot{},
+		&models.TaigaTask{},
+		&models.TaigaStatus{},
+		&models.TaigaTaskMetric{},
+		&models.TaigaItemRelationship{},
 	}
 }
 
@@ -84,10 +109,54 @@ func (p Taiga) SubTaskMetas() []plugin.SubTaskMeta {
 	return []plugin.SubTaskMeta{
 		tasks.CollectProjectsMeta,
 		tasks.ExtractProjectsMeta,
+		tasks.CollectProjectStatsMeta,
+		tasks.ExtractProjectStatsMeta,
+		tasks.CollectAccountsMeta,
+		tasks.ExtractAccountsMeta,
 		tasks.CollectUserStoriesMeta,
 		tasks.ExtractUserStoriesMeta,
+		tasks.CollectMilestonesMeta,
+		tasks.ExtractMilestonesMeta,
+		tasks.CollectMilestoneStatsMeta,
+		tasks.ExtractMilestoneStatsMeta,
+		tasks.CollectTaskStatusesMeta,
+		tasks.ExtractTaskStatusesMeta,
+		tasks.CollectTasksMeta,
+		tasks.ExtractTasksMeta,
+		tasks.CollectCustomAttributesMeta,
+		tasks.ExtractCustomAttributesMeta,
+		tasks.CollectCustomAttributeValuesMeta,
+		tasks.ExtractCustomAttributeValuesMeta,
+		tasks.CollectTaskCustomAttributeValuesMeta,
+		tasks.ExtractTaskCustomAttributeValuesMeta,
+		tasks.CollectUserStoryHistoriesMeta,
+		tasks.ExtractUserStoryHistoriesMeta,
+		tasks.CollectTaskHistoriesMeta,
+		tasks.ExtractTaskHistoriesMeta,
+		tasks.CollectUserStoryVotersMeta,
+		tasks.ExtractUserStoryVotersMeta,
+		tasks.CollectUserStoryAttachmentsMeta,
+		tasks.ExtractUserStoryAttachmentsMeta,
+		tasks.CollectTaskAttachmentsMeta,
+		tasks.ExtractTaskAttachmentsMeta,
+		tasks.CollectWikiPagesMeta,
+		tasks.ExtractWikiPagesMeta,
+		tasks.CollectWikiLinksMeta,
+		tasks.ExtractWikiLinksMeta,
+		tasks.CollectWikiHistoriesMeta,
+		tasks.ExtractWikiHistoriesMeta,
+		tasks.CalculateBlockedPeriodsMeta,
+		tasks.CalculateTaskMetricsMeta,
 		tasks.ConvertProjectsMeta,
+		tasks.ConvertAccountsMeta,
 		tasks.ConvertUserStoriesMeta,
+		tasks.ConvertTasksMeta,
+		tasks.ConvertIssueRelationshipsMeta,
+		tasks.ConvertIssueLabelsMeta,
+		tasks.ConvertIssueCommentsMeta,
+		tasks.ConvertIssueChangelogsMeta,
+		tasks.ConvertEngagementsMeta,
+		tasks.ConvertDocumentationActivitiesMeta,
 	}
 }
 
@@ -96,16 +165,16 @@ func (p Taiga) PrepareTaskData(taskCtx plugin.TaskContext, options map[string]in
 	var err errors.Error
 	logger := taskCtx.GetLogger()
 	logger.Debug("%v", options)
-	
+
 	err = helper.Decode(options, &op, nil)
 	if err != nil {
 		return nil, errors.Default.Wrap(err, "could not decode Taiga options")
 	}
-	
+
 	if op.ConnectionId == 0 {
 		return nil, errors.BadInput.New("taiga connectionId is invalid")
 	}
-	
+
 	connection := &models.TaigaConnection{}
 	connectionHelper := helper.NewConnectionHelper(
 		taskCtx,
@@ -116,10 +185,15 @@ func (p Taiga) PrepareTaskData(taskCtx plugin.TaskContext, options map[string]in
 	if err != nil {
 		return nil, errors.Default.Wrap(err, "unable to get Taiga connection")
 	}
-	
-	taigaApiClient, err := tasks.NewTaigaApiClient(taskCtx, connection)
-	if err != nil {
-		return nil, errors.Default.Wrap(err, "failed to create taiga api client")
+
+	// replaying raw data needs no Taiga server
+	var taigaApiClient *helper.ApiAsyncClient
+	var throttle *tasks.TaigaThrottle
+	if !op.ReplayRawData {
+		taigaApiClient, throttle, err = tasks.NewTaigaApiClient(taskCtx, connection)
+		if err != nil {
+			return nil, errors.Default.Wrap(err, "failed to create taiga api client")
+		}
 	}
 
 	if op.ProjectId != 0 {
@@ -138,7 +212,7 @@ func (p Taiga) PrepareTaskData(taskCtx plugin.TaskContext, options map[string]in
 			op.ScopeConfigId = scope.ScopeConfigId
 		}
 	}
-	
+
 	if op.ScopeConfig == nil && op.ScopeConfigId != 0 {
 		var scopeConfig models.TaigaScopeConfig
 		db := taskCtx.GetDal()
@@ -151,7 +225,7 @@ func (p Taiga) PrepareTaskData(taskCtx plugin.TaskContext, options map[string]in
 			return nil, errors.BadInput.Wrap(err, "fail to make scopeConfig")
 		}
 	}
-	
+
 	if op.ScopeConfig == nil && op.ScopeConfigId == 0 {
 		op.ScopeConfig = new(models.TaigaScopeConfig)
 	}
@@ -162,8 +236,19 @@ func (p Taiga) PrepareTaskData(taskCtx plugin.TaskContext, options map[string]in
 	}
 
 	taskData := &tasks.TaigaTaskData{
-		Options:   &op,
-		ApiClient: taigaApiClient,
+		Options:    &op,
+		ApiClient:  taigaApiClient,
+		Throttle:   throttle,
+		Connection: connection,
+	}
+	if op.TimeAfter != "" {
+		timeAfter, parseErr := time.Parse(time.RFC3339, op.TimeAfter)
+		if parseErr != nil {
+			return nil, errors.BadInput.Wrap(parseErr, "invalid timeAfter, expected an RFC 3339 time")
+		}
+		taskData.TimeAfter = &timeAfter
+	} else {
+		taskData.TimeAfter = op.ScopeConfig.TimeAfter
 	}
 
 	return taskData, nil
@@ -209,6 +294,9 @@ func (p Taiga) ApiResources() map[string]map[string]plugin.ApiResourceHandler {
 			"PATCH":  api.UpdateScope,
 			"DELETE": api.DeleteScope,
 		},
+		"connections/:connectionId/scopes/:scopeId/import": {
+			"POST": api.ImportProjectExport,
+		},
 		"connections/:connectionId/scopes": {
 			"GET": api.GetScopeList,
 			"PUT": api.PutScope,
@@ -230,6 +318,9 @@ func (p Taiga) Close(taskCtx plugin.TaskContext) errors.Error {
 	if !ok {
 		return errors.Default.New(fmt.Sprintf("GetData failed when try to close %+v", taskCtx))
 	}
-	data.ApiClient.Release()
+	// replayed tasks have no api client
+	if data.ApiClient != nil {
+		data.ApiClient.Release()
+	}
 	return nil
 }
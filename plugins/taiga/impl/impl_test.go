@@ -0,0 +1,49 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package impl
+
+import (
+	"testing"
+
+	"github.com/apache/incubator-devlake/core/plugin"
+	"github.com/irfanuddinahmad/taiga-devlake-plugin/plugins/taiga/tasks"
+	"github.com/stretchr/testify/assert"
+)
+
+// dataTaskContext is a task context only able to hand out its data
+type dataTaskContext struct {
+	plugin.TaskContext
+	data interface{}
+}
+
+func (c dataTaskContext) GetData() interface{} {
+	return c.data
+}
+
+func TestCloseReplayedTask(t *testing.T) {
+	var taiga Taiga
+	data := &tasks.TaigaTaskData{Options: &tasks.TaigaOptions{ConnectionId: 1, ProjectId: 1, ReplayRawData: true}}
+	assert.NotPanics(t, func() {
+		assert.Nil(t, taiga.Close(dataTaskContext{data: data}))
+	})
+}
+
+func TestCloseWithoutTaskData(t *testing.T) {
+	var taiga Taiga
+	assert.NotNil(t, taiga.Close(dataTaskContext{data: "not task data"}))
+}
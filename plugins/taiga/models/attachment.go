@@ -0,0 +1,45 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"time"
+
+	"github.com/apache/incubator-devlake/core/models/common"
+)
+
+// TaigaAttachment is the metadata of a file attached to a user story or task, the content is not downloaded
+type TaigaAttachment struct {
+	common.NoPKModel
+	ConnectionId uint64     `gorm:"primaryKey"`
+	AttachmentId uint64     `gorm:"primaryKey;autoIncrement:false" json:"id"`
+	ItemType     string     `gorm:"type:varchar(20)" json:"itemType"`
+	ItemId       uint64     `gorm:"index" json:"itemId"`
+	ProjectId    uint64     `gorm:"index" json:"projectId"`
+	Name         string     `gorm:"type:varchar(500)" json:"name"`
+	Description  string     `gorm:"type:text" json:"description"`
+	Size         int64      `json:"size"`
+	Url          string     `gorm:"type:text" json:"url"`
+	OwnerId      uint64     `json:"ownerId"`
+	CreatedDate  *time.Time `json:"createdDate"`
+	IsDeprecated bool       `json:"isDeprecated"`
+}
+
+func (TaigaAttachment) TableName() string {
+	return "_tool_taiga_attachments"
+}
@@ -0,0 +1,42 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"time"
+
+	"github.com/apache/incubator-devlake/core/models/common"
+)
+
+// TaigaBlockedPeriod is an interval during which a story, task or issue was blocked,
+// EndDate is nil while the item is still blocked
+type TaigaBlockedPeriod struct {
+	common.NoPKModel
+	ConnectionId   uint64     `gorm:"primaryKey"`
+	ItemType       string     `gorm:"primaryKey;type:varchar(20)" json:"itemType"`
+	ItemId         uint64     `gorm:"primaryKey;autoIncrement:false" json:"itemId"`
+	StartDate      time.Time  `gorm:"primaryKey" json:"startDate"`
+	EndDate        *time.Time `json:"endDate"`
+	ProjectId      uint64     `gorm:"index" json:"projectId"`
+	Reason         string     `gorm:"type:text" json:"reason"`
+	BlockedMinutes int64      `json:"blockedMinutes"`
+}
+
+func (TaigaBlockedPeriod) TableName() string {
+	return "_tool_taiga_blocked_periods"
+}
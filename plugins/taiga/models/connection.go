@@ -18,17 +18,172 @@ limitations under the License.
 package models
 
 import (
+	"crypto/tls"
+	"crypto/x509"
 	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
 
 	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/plugin"
 	"github.com/apache/incubator-devlake/core/utils"
 	helper "github.com/apache/incubator-devlake/helpers/pluginhelper/api"
 )
 
+// DefaultApiPrefix is where the Taiga REST API is mounted on a stock installation
+const DefaultApiPrefix = "api/v1"
+
+// taigaWebRoutes are the first path segments of the Taiga web app, everything from them on is
+// dropped when a pasted web URL is turned into an API URL
+var taigaWebRoutes = map[string]bool{
+	"project":       true,
+	"projects":      true,
+	"discover":      true,
+	"login":         true,
+	"register":      true,
+	"profile":       true,
+	"user-settings": true,
+	"notifications": true,
+}
+
 // TaigaConn holds the essential information to connect to the Taiga API
 type TaigaConn struct {
 	helper.RestConnection `mapstructure:",squash"`
 	helper.AccessToken    `mapstructure:",squash"`
+	// ApiPrefix is the path of the API relative to the Taiga base URL, defaults to api/v1
+	ApiPrefix string `mapstructure:"apiPrefix" json:"apiPrefix" gorm:"type:varchar(255)"`
+	// CaCert is a PEM encoded CA bundle used to verify self-signed or internal certificates
+	CaCert        string `mapstructure:"caCert" json:"caCert" gorm:"type:text"`
+	SkipTlsVerify bool   `mapstructure:"skipTlsVerify" json:"skipTlsVerify"`
+	// MaxParallelism is the number of projects a blueprint collects at the same time, 0 means all
+	MaxParallelism int `mapstructure:"maxParallelism" json:"maxParallelism"`
+	// AutoDiscoverProjects adds every remote project matching the patterns below to the blueprints
+	// of the connection when their pipelines are planned
+	AutoDiscoverProjects bool `mapstructure:"autoDiscoverProjects" json:"autoDiscoverProjects"`
+	// ProjectIncludePattern and ProjectExcludePattern are regular expressions matched against the
+	// name and the slug of remote projects, an empty include pattern matches every project
+	ProjectIncludePattern string `mapstructure:"projectIncludePattern" json:"projectIncludePattern" gorm:"type:varchar(255)"`
+	ProjectExcludePattern string `mapstructure:"projectExcludePattern" json:"projectExcludePattern" gorm:"type:varchar(255)"`
+	// DefaultScopeConfigId is given to the projects found by the auto-discovery
+	DefaultScopeConfigId uint64 `mapstructure:"defaultScopeConfigId" json:"defaultScopeConfigId"`
+}
+
+// DiscoveredProjectFilter compiles the project patterns of the connection into a filter telling
+// whether a remote project is picked up by the auto-discovery
+func (tc TaigaConn) DiscoveredProjectFilter() (func(name, slug string) bool, errors.Error) {
+	include, err := regexp.Compile(tc.ProjectIncludePattern)
+	if err != nil {
+		return nil, errors.BadInput.Wrap(err, "invalid project include pattern "+tc.ProjectIncludePattern)
+	}
+	var exclude *regexp.Regexp
+	if tc.ProjectExcludePattern != "" {
+		exclude, err = regexp.Compile(tc.ProjectExcludePattern)
+		if err != nil {
+			return nil, errors.BadInput.Wrap(err, "invalid project exclude pattern "+tc.ProjectExcludePattern)
+		}
+	}
+	return func(name, slug string) bool {
+		if exclude != nil && (exclude.MatchString(name) || exclude.MatchString(slug)) {
+			return false
+		}
+		return include.MatchString(name) || include.MatchString(slug)
+	}, nil
+}
+
+// ProjectUrl returns the link to a project in the Taiga web app
+func (tc TaigaConn) ProjectUrl(slug string) string {
+	prefix := strings.Trim(tc.ApiPrefix, "/")
+	if prefix == "" {
+		prefix = DefaultApiPrefix
+	}
+	endpoint, err := NormalizeEndpoint(tc.Endpoint, prefix)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSuffix(endpoint, prefix+"/") + "project/" + slug
+}
+
+// GetEndpoint returns the API URL derived from the configured endpoint, so paths are relative to the API root
+func (tc TaigaConn) GetEndpoint() string {
+	endpoint, err := NormalizeEndpoint(tc.Endpoint, tc.ApiPrefix)
+	if err != nil {
+		return tc.Endpoint
+	}
+	return endpoint
+}
+
+// PrepareApiClient applies the TLS settings of the connection to the api client, the proxy is
+// already taken care of by the RestConnection
+func (tc *TaigaConn) PrepareApiClient(apiClient plugin.ApiClient) errors.Error {
+	if tc.CaCert == "" && !tc.SkipTlsVerify {
+		return nil
+	}
+	client, ok := apiClient.(interface{ GetClient() *http.Client })
+	if !ok {
+		return errors.Default.New("the api client does not support custom TLS settings")
+	}
+	transport, ok := client.GetClient().Transport.(*http.Transport)
+	if !ok {
+		return errors.Default.New("the api client does not use a configurable http transport")
+	}
+	tlsConfig := &tls.Config{InsecureSkipVerify: tc.SkipTlsVerify} // #nosec G402 -- opted in per connection
+	if tc.CaCert != "" {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM([]byte(tc.CaCert)) {
+			return errors.BadInput.New("caCert does not contain any valid PEM certificate")
+		}
+		tlsConfig.RootCAs = pool
+	}
+	transport.TLSClientConfig = tlsConfig
+	return nil
+}
+
+// NormalizeEndpoint accepts either the Taiga web URL users copy from their browser
+// (e.g. https://taiga.example.com/project/foo/backlog) or an API URL and returns the
+// API root with a trailing slash, e.g. https://taiga.example.com/api/v1/
+func NormalizeEndpoint(endpoint string, apiPrefix string) (string, errors.Error) {
+	u, err := url.Parse(strings.TrimSpace(endpoint))
+	if err != nil {
+		return "", errors.BadInput.Wrap(err, "invalid endpoint")
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return "", errors.BadInput.New("endpoint must start with http:// or https://")
+	}
+	prefix := strings.Trim(apiPrefix, "/")
+	if prefix == "" {
+		prefix = DefaultApiPrefix
+	}
+
+	// keep everything in front of the api prefix or the first web route, Taiga may live under a sub path
+	path := "/" + strings.Trim(u.Path, "/") + "/"
+	if idx := strings.Index(path, "/"+prefix+"/"); idx >= 0 {
+		path = path[:idx+1]
+	} else {
+		segments := strings.Split(strings.Trim(path, "/"), "/")
+		var base []string
+		for _, segment := range segments {
+			if taigaWebRoutes[segment] {
+				break
+			}
+			if segment != "" {
+				base = append(base, segment)
+			}
+		}
+		path = "/" + strings.Join(base, "/")
+		if len(base) > 0 {
+			path += "/"
+		}
+	}
+
+	u.Path = path + prefix + "/"
+	u.RawPath = ""
+	u.RawQuery = ""
+	u.Fragment = ""
+	return u.String(), nil
 }
 
 func (tc *TaigaConn) Sanitize() TaigaConn {
@@ -68,6 +223,10 @@ func (connection *TaigaConnection) MergeFromRequest(target *TaigaConnection, bod
 		target.Token = token
 	}
 
+	if _, err := target.DiscoveredProjectFilter(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -0,0 +1,138 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeEndpoint(t *testing.T) {
+	tests := []struct {
+		name      string
+		endpoint  string
+		apiPrefix string
+		want      string
+		wantErr   bool
+	}{
+		{"api url", "https://taiga.example.com/api/v1/", "", "https://taiga.example.com/api/v1/", false},
+		{"api url without trailing slash", "https://taiga.example.com/api/v1", "", "https://taiga.example.com/api/v1/", false},
+		{"base url", "https://taiga.example.com", "", "https://taiga.example.com/api/v1/", false},
+		{"web url of a project", "https://taiga.example.com/project/foo/backlog", "", "https://taiga.example.com/api/v1/", false},
+		{"web url under a sub path", "https://example.com/taiga/project/foo/us/12", "", "https://example.com/taiga/api/v1/", false},
+		{"api url under a sub path", "https://example.com/taiga/api/v1/projects", "", "https://example.com/taiga/api/v1/", false},
+		{"query and fragment are dropped", "https://taiga.example.com/discover?q=x#top", "", "https://taiga.example.com/api/v1/", false},
+		{"custom api prefix", "https://taiga.example.com/", "/rest/v2/", "https://taiga.example.com/rest/v2/", false},
+		{"custom api prefix in url", "https://taiga.example.com/rest/v2/userstories", "rest/v2", "https://taiga.example.com/rest/v2/", false},
+		{"surrounding spaces", "  http://localhost:9000  ", "", "http://localhost:9000/api/v1/", false},
+		{"missing scheme", "taiga.example.com", "", "", true},
+		{"unsupported scheme", "ftp://taiga.example.com", "", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NormalizeEndpoint(tt.endpoint, tt.apiPrefix)
+			if tt.wantErr {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestDiscoveredProjectFilter(t *testing.T) {
+	type project struct {
+		name string
+		slug string
+	}
+	tests := []struct {
+		name    string
+		include string
+		exclude string
+		picked  []project
+		skipped []project
+		wantErr bool
+	}{
+		{
+			name:   "no patterns pick everything",
+			picked: []project{{"Mobile App", "acme-mobile-app"}, {"Sandbox", "sandbox"}},
+		},
+		{
+			name:    "include matches the name or the slug",
+			include: "^acme-",
+			picked:  []project{{"Mobile App", "acme-mobile-app"}, {"acme-web", "web"}},
+			skipped: []project{{"Sandbox", "sandbox"}},
+		},
+		{
+			name:    "exclude wins over include",
+			include: "^acme-",
+			exclude: "(?i)archive",
+			picked:  []project{{"Mobile App", "acme-mobile-app"}},
+			skipped: []project{{"Archived Web", "acme-web"}, {"Legacy", "acme-archive"}},
+		},
+		{
+			name:    "exclude alone",
+			exclude: "^sandbox$",
+			picked:  []project{{"Mobile App", "acme-mobile-app"}},
+			skipped: []project{{"Sandbox", "sandbox"}},
+		},
+		{name: "invalid include", include: "(", wantErr: true},
+		{name: "invalid exclude", exclude: "[", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn := TaigaConn{ProjectIncludePattern: tt.include, ProjectExcludePattern: tt.exclude}
+			matches, err := conn.DiscoveredProjectFilter()
+			if tt.wantErr {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			for _, p := range tt.picked {
+				assert.True(t, matches(p.name, p.slug), "%s should be picked", p.slug)
+			}
+			for _, p := range tt.skipped {
+				assert.False(t, matches(p.name, p.slug), "%s should be skipped", p.slug)
+			}
+		})
+	}
+}
+
+func TestProjectUrl(t *testing.T) {
+	tests := []struct {
+		name      string
+		endpoint  string
+		apiPrefix string
+		want      string
+	}{
+		{"api url", "https://taiga.example.com/api/v1/", "", "https://taiga.example.com/project/acme-web"},
+		{"base url", "https://taiga.example.com", "", "https://taiga.example.com/project/acme-web"},
+		{"sub path", "https://example.com/taiga/api/v1", "", "https://example.com/taiga/project/acme-web"},
+		{"custom api prefix", "https://taiga.example.com/rest/v2/", "rest/v2", "https://taiga.example.com/project/acme-web"},
+		{"invalid endpoint", "taiga.example.com", "", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn := TaigaConn{ApiPrefix: tt.apiPrefix}
+			conn.Endpoint = tt.endpoint
+			assert.Equal(t, tt.want, conn.ProjectUrl("acme-web"))
+		})
+	}
+}
@@ -0,0 +1,63 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"github.com/apache/incubator-devlake/core/models/common"
+)
+
+// Item types custom attributes can be defined for, they match the prefixes of the Taiga API paths
+const (
+	ItemTypeUserStory = "userstory"
+	ItemTypeTask      = "task"
+	ItemTypeIssue     = "issue"
+	ItemTypeEpic      = "epic"
+)
+
+// TaigaCustomAttribute is a custom attribute defined on a project for one item type, Taiga numbers
+// the attributes of every item type on their own
+type TaigaCustomAttribute struct {
+	common.NoPKModel
+	ConnectionId uint64 `gorm:"primaryKey"`
+	ItemType     string `gorm:"primaryKey;type:varchar(20)" json:"itemType"`
+	AttributeId  uint64 `gorm:"primaryKey;autoIncrement:false" json:"id"`
+	ProjectId    uint64 `gorm:"index" json:"projectId"`
+	Name         string `gorm:"type:varchar(255)" json:"name"`
+	Description  string `gorm:"type:text" json:"description"`
+	Type         string `gorm:"type:varchar(20)" json:"type"`
+	Order        int    `json:"order"`
+}
+
+func (TaigaCustomAttribute) TableName() string {
+	return "_tool_taiga_custom_attributes"
+}
+
+// TaigaCustomAttributeValue is the value of one custom attribute on one item, stored as text
+type TaigaCustomAttributeValue struct {
+	common.NoPKModel
+	ConnectionId uint64 `gorm:"primaryKey"`
+	ItemType     string `gorm:"primaryKey;type:varchar(20)" json:"itemType"`
+	ItemId       uint64 `gorm:"primaryKey;autoIncrement:false" json:"itemId"`
+	AttributeId  uint64 `gorm:"primaryKey;autoIncrement:false" json:"attributeId"`
+	ProjectId    uint64 `gorm:"index" json:"projectId"`
+	Value        string `gorm:"type:text" json:"value"`
+}
+
+func (TaigaCustomAttributeValue) TableName() string {
+	return "_tool_taiga_custom_attribute_values"
+}
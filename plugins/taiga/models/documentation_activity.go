@@ -0,0 +1,48 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"time"
+
+	"github.com/apache/incubator-devlake/core/models/domainlayer"
+)
+
+// Types of TaigaDocumentationActivity
+const (
+	DocumentationActivityCreated = "CREATED"
+	DocumentationActivityEdited  = "EDITED"
+)
+
+// TaigaDocumentationActivity is the creation or edit of a wiki page. DevLake has no domain type
+// for documentation yet, so the rows carry the domain ids of their board, page and author for
+// Grafana to join with boards and accounts.
+type TaigaDocumentationActivity struct {
+	domainlayer.DomainEntity
+	BoardId      string    `gorm:"type:varchar(255);index"`
+	PageId       string    `gorm:"type:varchar(255);index"`
+	PageTitle    string    `gorm:"type:varchar(500)"`
+	AuthorId     string    `gorm:"type:varchar(255)"`
+	AuthorName   string    `gorm:"type:varchar(255)"`
+	ActivityType string    `gorm:"type:varchar(20)"`
+	CreatedDate  time.Time `gorm:"index"`
+}
+
+func (TaigaDocumentationActivity) TableName() string {
+	return "_tool_taiga_documentation_activities"
+}
@@ -0,0 +1,49 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"github.com/apache/incubator-devlake/core/models/common"
+)
+
+// Types of links between Taiga items, read from the source to the target
+const (
+	// RelationshipParent links a user story to one of its tasks
+	RelationshipParent = "parent"
+	// RelationshipGeneratedFrom links a user story to the issue or task it was promoted from
+	RelationshipGeneratedFrom = "generated_from"
+	// RelationshipDuplicate links a user story or task to the item it duplicates, Taiga has no such
+	// link so it is read from the custom attribute mapped onto duplicateOf
+	RelationshipDuplicate = "duplicate"
+)
+
+// TaigaItemRelationship is a link between two stories, tasks, issues or epics
+type TaigaItemRelationship struct {
+	common.NoPKModel
+	ConnectionId     uint64 `gorm:"primaryKey"`
+	SourceItemType   string `gorm:"primaryKey;type:varchar(20)" json:"sourceItemType"`
+	SourceItemId     uint64 `gorm:"primaryKey;autoIncrement:false" json:"sourceItemId"`
+	TargetItemType   string `gorm:"primaryKey;type:varchar(20)" json:"targetItemType"`
+	TargetItemId     uint64 `gorm:"primaryKey;autoIncrement:false" json:"targetItemId"`
+	RelationshipType string `gorm:"primaryKey;type:varchar(20)" json:"relationshipType"`
+	ProjectId        uint64 `gorm:"index" json:"projectId"`
+}
+
+func (TaigaItemRelationship) TableName() string {
+	return "_tool_taiga_item_relationships"
+}
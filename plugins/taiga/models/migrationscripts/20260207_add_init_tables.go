@@ -0,0 +1,118 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package migrationscripts
+
+import (
+	"time"
+
+	"github.com/apache/incubator-devlake/core/context"
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/models/migrationscripts/archived"
+	"github.com/apache/incubator-devlake/helpers/migrationhelper"
+)
+
+type taigaConnection20260207 struct {
+	archived.Model
+	Name             string `gorm:"type:varchar(100);uniqueIndex"`
+	Endpoint         string `gorm:"type:varchar(255)"`
+	Proxy            string `gorm:"type:varchar(255)"`
+	RateLimitPerHour int
+	Token            string `gorm:"type:varchar(255)"`
+}
+
+func (taigaConnection20260207) TableName() string {
+	return "_tool_taiga_connections"
+}
+
+type taigaProject20260207 struct {
+	archived.NoPKModel
+	ConnectionId     uint64 `gorm:"primaryKey"`
+	ScopeConfigId    uint64
+	ProjectId        uint64 `gorm:"primaryKey"`
+	Name             string `gorm:"type:varchar(255)"`
+	Slug             string `gorm:"type:varchar(255)"`
+	Description      string `gorm:"type:text"`
+	Url              string `gorm:"type:varchar(255)"`
+	IsPrivate        bool
+	TotalMilestones  int
+	TotalStoryPoints float64
+}
+
+func (taigaProject20260207) TableName() string {
+	return "_tool_taiga_projects"
+}
+
+type taigaUserStory20260207 struct {
+	archived.NoPKModel
+	ConnectionId   uint64 `gorm:"primaryKey"`
+	ProjectId      uint64 `gorm:"index"`
+	UserStoryId    uint64 `gorm:"primaryKey;autoIncrement:false"`
+	Ref            int
+	Subject        string `gorm:"type:varchar(255)"`
+	Description    string `gorm:"type:text"`
+	Status         string `gorm:"type:varchar(100)"`
+	StatusColor    string `gorm:"type:varchar(20)"`
+	IsClosed       bool
+	CreatedDate    *time.Time
+	ModifiedDate   *time.Time
+	FinishedDate   *time.Time
+	AssignedTo     uint64
+	AssignedToName string `gorm:"type:varchar(255)"`
+	TotalPoints    float64
+	MilestoneId    uint64
+	MilestoneName  string `gorm:"type:varchar(255)"`
+	Priority       int
+	IsBlocked      bool
+	BlockedNote    string `gorm:"type:text"`
+}
+
+func (taigaUserStory20260207) TableName() string {
+	return "_tool_taiga_user_stories"
+}
+
+type taigaScopeConfig20260207 struct {
+	archived.Model
+	Entities     []string `gorm:"type:json;serializer:json"`
+	ConnectionId uint64
+	Name         string                 `gorm:"type:varchar(255);uniqueIndex"`
+	TypeMappings map[string]interface{} `gorm:"type:json;serializer:json"`
+}
+
+func (taigaScopeConfig20260207) TableName() string {
+	return "_tool_taiga_scope_configs"
+}
+
+type addInitTables struct{}
+
+func (*addInitTables) Up(basicRes context.BasicRes) errors.Error {
+	return migrationhelper.AutoMigrateTables(
+		basicRes,
+		&taigaConnection20260207{},
+		&taigaProject20260207{},
+		&taigaUserStory20260207{},
+		&taigaScopeConfig20260207{},
+	)
+}
+
+func (*addInitTables) Version() uint64 {
+	return 20260207000001
+}
+
+func (*addInitTables) Name() string {
+	return "taiga init schemas"
+}
@@ -0,0 +1,97 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package migrationscripts
+
+import (
+	"github.com/apache/incubator-devlake/core/context"
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/models/migrationscripts/archived"
+	"github.com/apache/incubator-devlake/helpers/migrationhelper"
+)
+
+type taigaAccount20261019 struct {
+	archived.NoPKModel
+	ConnectionId uint64 `gorm:"primaryKey"`
+	AccountId    uint64 `gorm:"primaryKey;autoIncrement:false"`
+	Username     string `gorm:"type:varchar(255)"`
+	FullName     string `gorm:"type:varchar(255)"`
+	Email        string `gorm:"type:varchar(255)"`
+	AvatarUrl    string `gorm:"type:varchar(255)"`
+	IsActive     bool
+}
+
+func (taigaAccount20261019) TableName() string {
+	return "_tool_taiga_accounts"
+}
+
+type taigaItemWatcher20261019 struct {
+	archived.NoPKModel
+	ConnectionId uint64 `gorm:"primaryKey"`
+	ItemType     string `gorm:"primaryKey;type:varchar(20)"`
+	ItemId       uint64 `gorm:"primaryKey;autoIncrement:false"`
+	AccountId    uint64 `gorm:"primaryKey;autoIncrement:false"`
+	ProjectId    uint64 `gorm:"index"`
+}
+
+func (taigaItemWatcher20261019) TableName() string {
+	return "_tool_taiga_item_watchers"
+}
+
+type taigaItemVoter20261019 struct {
+	archived.NoPKModel
+	ConnectionId uint64 `gorm:"primaryKey"`
+	ItemType     string `gorm:"primaryKey;type:varchar(20)"`
+	ItemId       uint64 `gorm:"primaryKey;autoIncrement:false"`
+	AccountId    uint64 `gorm:"primaryKey;autoIncrement:false"`
+	ProjectId    uint64 `gorm:"index"`
+	Username     string `gorm:"type:varchar(255)"`
+	FullName     string `gorm:"type:varchar(255)"`
+}
+
+func (taigaItemVoter20261019) TableName() string {
+	return "_tool_taiga_item_voters"
+}
+
+type taigaUserStoryEngagement20261019 struct {
+	TotalWatchers int
+	TotalVoters   int
+}
+
+func (taigaUserStoryEngagement20261019) TableName() string {
+	return "_tool_taiga_user_stories"
+}
+
+type addAccountsAndEngagements struct{}
+
+func (*addAccountsAndEngagements) Up(basicRes context.BasicRes) errors.Error {
+	return migrationhelper.AutoMigrateTables(
+		basicRes,
+		&taigaAccount20261019{},
+		&taigaItemWatcher20261019{},
+		&taigaItemVoter20261019{},
+		&taigaUserStoryEngagement20261019{},
+	)
+}
+
+func (*addAccountsAndEngagements) Version() uint64 {
+	return 20261019000006
+}
+
+func (*addAccountsAndEngagements) Name() string {
+	return "add taiga accounts, watchers and voters"
+}
@@ -0,0 +1,82 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package migrationscripts
+
+import (
+	"github.com/apache/incubator-devlake/core/context"
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/models/migrationscripts/archived"
+	"github.com/apache/incubator-devlake/helpers/migrationhelper"
+)
+
+type taigaCustomAttribute20261019 struct {
+	archived.NoPKModel
+	ConnectionId uint64 `gorm:"primaryKey"`
+	ItemType     string `gorm:"primaryKey;type:varchar(20)"`
+	AttributeId  uint64 `gorm:"primaryKey;autoIncrement:false"`
+	ProjectId    uint64 `gorm:"index"`
+	Name         string `gorm:"type:varchar(255)"`
+	Description  string `gorm:"type:text"`
+	Type         string `gorm:"type:varchar(20)"`
+	Order        int
+}
+
+func (taigaCustomAttribute20261019) TableName() string {
+	return "_tool_taiga_custom_attributes"
+}
+
+type taigaCustomAttributeValue20261019 struct {
+	archived.NoPKModel
+	ConnectionId uint64 `gorm:"primaryKey"`
+	ItemType     string `gorm:"primaryKey;type:varchar(20)"`
+	ItemId       uint64 `gorm:"primaryKey;autoIncrement:false"`
+	AttributeId  uint64 `gorm:"primaryKey;autoIncrement:false"`
+	ProjectId    uint64 `gorm:"index"`
+	Value        string `gorm:"type:text"`
+}
+
+func (taigaCustomAttributeValue20261019) TableName() string {
+	return "_tool_taiga_custom_attribute_values"
+}
+
+type taigaScopeConfig20261019 struct {
+	CustomAttributeMappings map[string]interface{} `gorm:"type:json;serializer:json"`
+}
+
+func (taigaScopeConfig20261019) TableName() string {
+	return "_tool_taiga_scope_configs"
+}
+
+type addCustomAttributes struct{}
+
+func (*addCustomAttributes) Up(basicRes context.BasicRes) errors.Error {
+	return migrationhelper.AutoMigrateTables(
+		basicRes,
+		&taigaCustomAttribute20261019{},
+		&taigaCustomAttributeValue20261019{},
+		&taigaScopeConfig20261019{},
+	)
+}
+
+func (*addCustomAttributes) Version() uint64 {
+	return 20261019000002
+}
+
+func (*addCustomAttributes) Name() string {
+	return "add taiga custom attributes and their mappings"
+}
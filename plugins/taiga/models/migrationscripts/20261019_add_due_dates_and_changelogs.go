@@ -0,0 +1,73 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package migrationscripts
+
+import (
+	"time"
+
+	"github.com/apache/incubator-devlake/core/context"
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/models/migrationscripts/archived"
+	"github.com/apache/incubator-devlake/helpers/migrationhelper"
+)
+
+type taigaIssueChangelog20261019 struct {
+	archived.NoPKModel
+	ConnectionId uint64 `gorm:"primaryKey"`
+	ChangelogId  string `gorm:"primaryKey;type:varchar(100)"`
+	FieldName    string `gorm:"primaryKey;type:varchar(100)"`
+	ItemType     string `gorm:"type:varchar(20)"`
+	ItemId       uint64 `gorm:"index"`
+	ProjectId    uint64 `gorm:"index"`
+	AuthorId     uint64
+	AuthorName   string `gorm:"type:varchar(255)"`
+	FromValue    string `gorm:"type:text"`
+	ToValue      string `gorm:"type:text"`
+	CreatedDate  *time.Time
+}
+
+func (taigaIssueChangelog20261019) TableName() string {
+	return "_tool_taiga_issue_changelogs"
+}
+
+type taigaUserStoryDueDate20261019 struct {
+	DueDate       *time.Time
+	DueDateReason string `gorm:"type:text"`
+}
+
+func (taigaUserStoryDueDate20261019) TableName() string {
+	return "_tool_taiga_user_stories"
+}
+
+type addDueDatesAndChangelogs struct{}
+
+func (*addDueDatesAndChangelogs) Up(basicRes context.BasicRes) errors.Error {
+	return migrationhelper.AutoMigrateTables(
+		basicRes,
+		&taigaIssueChangelog20261019{},
+		&taigaUserStoryDueDate20261019{},
+	)
+}
+
+func (*addDueDatesAndChangelogs) Version() uint64 {
+	return 20261019000008
+}
+
+func (*addDueDatesAndChangelogs) Name() string {
+	return "add taiga due dates and issue changelogs"
+}
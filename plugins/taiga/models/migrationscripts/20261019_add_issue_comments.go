@@ -0,0 +1,60 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package migrationscripts
+
+import (
+	"time"
+
+	"github.com/apache/incubator-devlake/core/context"
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/models/migrationscripts/archived"
+	"github.com/apache/incubator-devlake/helpers/migrationhelper"
+)
+
+type taigaIssueComment20261019 struct {
+	archived.NoPKModel
+	ConnectionId uint64 `gorm:"primaryKey"`
+	CommentId    string `gorm:"primaryKey;type:varchar(100)"`
+	ItemType     string `gorm:"type:varchar(20)"`
+	ItemId       uint64 `gorm:"index"`
+	ProjectId    uint64 `gorm:"index"`
+	AuthorId     uint64
+	AuthorName   string `gorm:"type:varchar(255)"`
+	Body         string `gorm:"type:text"`
+	CreatedDate  *time.Time
+	EditedDate   *time.Time
+	DeletedDate  *time.Time
+}
+
+func (taigaIssueComment20261019) TableName() string {
+	return "_tool_taiga_issue_comments"
+}
+
+type addIssueComments struct{}
+
+func (*addIssueComments) Up(basicRes context.BasicRes) errors.Error {
+	return migrationhelper.AutoMigrateTables(basicRes, &taigaIssueComment20261019{})
+}
+
+func (*addIssueComments) Version() uint64 {
+	return 20261019000005
+}
+
+func (*addIssueComments) Name() string {
+	return "add taiga issue comments"
+}
@@ -0,0 +1,67 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package migrationscripts
+
+import (
+	"github.com/apache/incubator-devlake/core/context"
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/models/migrationscripts/archived"
+	"github.com/apache/incubator-devlake/helpers/migrationhelper"
+)
+
+type taigaIssueLabel20261019 struct {
+	archived.NoPKModel
+	ConnectionId uint64 `gorm:"primaryKey"`
+	ItemType     string `gorm:"primaryKey;type:varchar(20)"`
+	ItemId       uint64 `gorm:"primaryKey;autoIncrement:false"`
+	LabelName    string `gorm:"primaryKey;type:varchar(255)"`
+	ProjectId    uint64 `gorm:"index"`
+	Color        string `gorm:"type:varchar(20)"`
+}
+
+func (taigaIssueLabel20261019) TableName() string {
+	return "_tool_taiga_issue_labels"
+}
+
+type taigaScopeConfigIssueTypes20261019 struct {
+	IssueTypeRequirement string `gorm:"type:varchar(255)"`
+	IssueTypeBug         string `gorm:"type:varchar(255)"`
+	IssueTypeIncident    string `gorm:"type:varchar(255)"`
+}
+
+func (taigaScopeConfigIssueTypes20261019) TableName() string {
+	return "_tool_taiga_scope_configs"
+}
+
+type addIssueLabels struct{}
+
+func (*addIssueLabels) Up(basicRes context.BasicRes) errors.Error {
+	return migrationhelper.AutoMigrateTables(
+		basicRes,
+		&taigaIssueLabel20261019{},
+		&taigaScopeConfigIssueTypes20261019{},
+	)
+}
+
+func (*addIssueLabels) Version() uint64 {
+	return 20261019000004
+}
+
+func (*addIssueLabels) Name() string {
+	return "add taiga issue labels and tag based issue types"
+}
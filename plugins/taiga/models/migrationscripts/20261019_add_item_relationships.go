@@ -0,0 +1,54 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package migrationscripts
+
+import (
+	"github.com/apache/incubator-devlake/core/context"
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/models/migrationscripts/archived"
+	"github.com/apache/incubator-devlake/helpers/migrationhelper"
+)
+
+type taigaItemRelationship20261019 struct {
+	archived.NoPKModel
+	ConnectionId     uint64 `gorm:"primaryKey"`
+	SourceItemType   string `gorm:"primaryKey;type:varchar(20)"`
+	SourceItemId     uint64 `gorm:"primaryKey;autoIncrement:false"`
+	TargetItemType   string `gorm:"primaryKey;type:varchar(20)"`
+	TargetItemId     uint64 `gorm:"primaryKey;autoIncrement:false"`
+	RelationshipType string `gorm:"primaryKey;type:varchar(20)"`
+	ProjectId        uint64 `gorm:"index"`
+}
+
+func (taigaItemRelationship20261019) TableName() string {
+	return "_tool_taiga_item_relationships"
+}
+
+type addItemRelationships struct{}
+
+func (*addItemRelationships) Up(basicRes context.BasicRes) errors.Error {
+	return migrationhelper.AutoMigrateTables(basicRes, &taigaItemRelationship20261019{})
+}
+
+func (*addItemRelationships) Version() uint64 {
+	return 20261019000019
+}
+
+func (*addItemRelationships) Name() string {
+	return "add taiga item relationships"
+}
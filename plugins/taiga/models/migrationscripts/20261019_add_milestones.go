@@ -0,0 +1,81 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package migrationscripts
+
+import (
+	"time"
+
+	"github.com/apache/incubator-devlake/core/context"
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/models/migrationscripts/archived"
+	"github.com/apache/incubator-devlake/helpers/migrationhelper"
+)
+
+type taigaMilestone20261019 struct {
+	archived.NoPKModel
+	ConnectionId    uint64 `gorm:"primaryKey"`
+	MilestoneId     uint64 `gorm:"primaryKey;autoIncrement:false"`
+	ProjectId       uint64 `gorm:"index"`
+	Name            string `gorm:"type:varchar(255)"`
+	Slug            string `gorm:"type:varchar(255)"`
+	EstimatedStart  *time.Time
+	EstimatedFinish *time.Time
+	Closed          bool
+	TotalPoints     float64
+	ClosedPoints    float64
+	CreatedDate     *time.Time
+	ModifiedDate    *time.Time
+}
+
+func (taigaMilestone20261019) TableName() string {
+	return "_tool_taiga_milestones"
+}
+
+type taigaMilestoneBurndown20261019 struct {
+	archived.NoPKModel
+	ConnectionId    uint64    `gorm:"primaryKey"`
+	MilestoneId     uint64    `gorm:"primaryKey;autoIncrement:false"`
+	Day             time.Time `gorm:"primaryKey;type:date"`
+	ProjectId       uint64    `gorm:"index"`
+	TotalPoints     float64
+	OpenPoints      float64
+	CompletedPoints float64
+	OptimalPoints   float64
+}
+
+func (taigaMilestoneBurndown20261019) TableName() string {
+	return "_tool_taiga_milestone_burndowns"
+}
+
+type addMilestones struct{}
+
+func (*addMilestones) Up(basicRes context.BasicRes) errors.Error {
+	return migrationhelper.AutoMigrateTables(
+		basicRes,
+		&taigaMilestone20261019{},
+		&taigaMilestoneBurndown20261019{},
+	)
+}
+
+func (*addMilestones) Version() uint64 {
+	return 20261019000011
+}
+
+func (*addMilestones) Name() string {
+	return "add taiga milestones and burndowns"
+}
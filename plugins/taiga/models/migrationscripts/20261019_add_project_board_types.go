@@ -0,0 +1,47 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package migrationscripts
+
+import (
+	"github.com/apache/incubator-devlake/core/context"
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/helpers/migrationhelper"
+)
+
+type taigaProjectBoardType20261019 struct {
+	IsBacklogActivated bool
+	IsKanbanActivated  bool
+}
+
+func (taigaProjectBoardType20261019) TableName() string {
+	return "_tool_taiga_projects"
+}
+
+type addProjectBoardTypes struct{}
+
+func (*addProjectBoardTypes) Up(basicRes context.BasicRes) errors.Error {
+	return migrationhelper.AutoMigrateTables(basicRes, &taigaProjectBoardType20261019{})
+}
+
+func (*addProjectBoardTypes) Version() uint64 {
+	return 20261019000016
+}
+
+func (*addProjectBoardTypes) Name() string {
+	return "add backlog and kanban flags to taiga projects"
+}
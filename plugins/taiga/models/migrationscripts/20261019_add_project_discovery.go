@@ -0,0 +1,49 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package migrationscripts
+
+import (
+	"github.com/apache/incubator-devlake/core/context"
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/helpers/migrationhelper"
+)
+
+type taigaConnectionProjectDiscovery20261019 struct {
+	AutoDiscoverProjects  bool
+	ProjectIncludePattern string `gorm:"type:varchar(255)"`
+	ProjectExcludePattern string `gorm:"type:varchar(255)"`
+	DefaultScopeConfigId  uint64
+}
+
+func (taigaConnectionProjectDiscovery20261019) TableName() string {
+	return "_tool_taiga_connections"
+}
+
+type addProjectDiscovery struct{}
+
+func (*addProjectDiscovery) Up(basicRes context.BasicRes) errors.Error {
+	return migrationhelper.AutoMigrateTables(basicRes, &taigaConnectionProjectDiscovery20261019{})
+}
+
+func (*addProjectDiscovery) Version() uint64 {
+	return 20261019000015
+}
+
+func (*addProjectDiscovery) Name() string {
+	return "add project auto-discovery settings to taiga connections"
+}
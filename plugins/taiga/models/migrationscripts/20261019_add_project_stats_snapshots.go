@@ -0,0 +1,65 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package migrationscripts
+
+import (
+	"time"
+
+	"github.com/apache/incubator-devlake/core/context"
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/models/migrationscripts/archived"
+	"github.com/apache/incubator-devlake/helpers/migrationhelper"
+)
+
+type taigaProjectStatsSnapshot20261019 struct {
+	archived.NoPKModel
+	ConnectionId      uint64    `gorm:"primaryKey"`
+	ProjectId         uint64    `gorm:"primaryKey;autoIncrement:false"`
+	SnapshotDate      time.Time `gorm:"primaryKey;type:date"`
+	TotalMilestones   int
+	TotalPoints       float64
+	DefinedPoints     float64
+	AssignedPoints    float64
+	ClosedPoints      float64
+	Speed             float64
+	TotalIssues       int
+	OpenedIssues      int
+	ClosedIssues      int
+	IssuesPerStatus   map[string]int `gorm:"type:json;serializer:json"`
+	IssuesPerSeverity map[string]int `gorm:"type:json;serializer:json"`
+	IssuesPerPriority map[string]int `gorm:"type:json;serializer:json"`
+	IssuesPerType     map[string]int `gorm:"type:json;serializer:json"`
+}
+
+func (taigaProjectStatsSnapshot20261019) TableName() string {
+	return "_tool_taiga_project_stats_snapshots"
+}
+
+type addProjectStatsSnapshots struct{}
+
+func (*addProjectStatsSnapshots) Up(basicRes context.BasicRes) errors.Error {
+	return migrationhelper.AutoMigrateTables(basicRes, &taigaProjectStatsSnapshot20261019{})
+}
+
+func (*addProjectStatsSnapshots) Version() uint64 {
+	return 20261019000012
+}
+
+func (*addProjectStatsSnapshots) Name() string {
+	return "add taiga project stats snapshots"
+}
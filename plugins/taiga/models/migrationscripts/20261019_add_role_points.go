@@ -0,0 +1,95 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package migrationscripts
+
+import (
+	"github.com/apache/incubator-devlake/core/context"
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/models/migrationscripts/archived"
+	"github.com/apache/incubator-devlake/helpers/migrationhelper"
+)
+
+type taigaRole20261019 struct {
+	archived.NoPKModel
+	ConnectionId uint64 `gorm:"primaryKey"`
+	RoleId       uint64 `gorm:"primaryKey;autoIncrement:false"`
+	ProjectId    uint64 `gorm:"index"`
+	Name         string `gorm:"type:varchar(255)"`
+	Slug         string `gorm:"type:varchar(255)"`
+	Computable   bool
+	Order        int
+}
+
+func (taigaRole20261019) TableName() string {
+	return "_tool_taiga_roles"
+}
+
+type taigaPoint20261019 struct {
+	archived.NoPKModel
+	ConnectionId uint64 `gorm:"primaryKey"`
+	PointId      uint64 `gorm:"primaryKey;autoIncrement:false"`
+	ProjectId    uint64 `gorm:"index"`
+	Name         string `gorm:"type:varchar(100)"`
+	Value        *float64
+	Order        int
+}
+
+func (taigaPoint20261019) TableName() string {
+	return "_tool_taiga_points"
+}
+
+type taigaUserStoryRolePoint20261019 struct {
+	archived.NoPKModel
+	ConnectionId uint64 `gorm:"primaryKey"`
+	UserStoryId  uint64 `gorm:"primaryKey;autoIncrement:false"`
+	RoleId       uint64 `gorm:"primaryKey;autoIncrement:false"`
+	ProjectId    uint64 `gorm:"index"`
+	PointId      uint64
+}
+
+func (taigaUserStoryRolePoint20261019) TableName() string {
+	return "_tool_taiga_user_story_role_points"
+}
+
+type taigaScopeConfigStoryPointRole20261019 struct {
+	StoryPointRole string `gorm:"type:varchar(255)"`
+}
+
+func (taigaScopeConfigStoryPointRole20261019) TableName() string {
+	return "_tool_taiga_scope_configs"
+}
+
+type addRolePoints struct{}
+
+func (*addRolePoints) Up(basicRes context.BasicRes) errors.Error {
+	return migrationhelper.AutoMigrateTables(
+		basicRes,
+		&taigaRole20261019{},
+		&taigaPoint20261019{},
+		&taigaUserStoryRolePoint20261019{},
+		&taigaScopeConfigStoryPointRole20261019{},
+	)
+}
+
+func (*addRolePoints) Version() uint64 {
+	return 20261019000003
+}
+
+func (*addRolePoints) Name() string {
+	return "add taiga roles, points and per role story estimates"
+}
@@ -0,0 +1,99 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package migrationscripts
+
+import (
+	"time"
+
+	"github.com/apache/incubator-devlake/core/context"
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/models/migrationscripts/archived"
+	"github.com/apache/incubator-devlake/helpers/migrationhelper"
+)
+
+type taigaTask20261019 struct {
+	archived.NoPKModel
+	ConnectionId   uint64 `gorm:"primaryKey"`
+	TaskId         uint64 `gorm:"primaryKey;autoIncrement:false"`
+	ProjectId      uint64 `gorm:"index"`
+	UserStoryId    uint64
+	MilestoneId    uint64
+	Ref            int
+	Subject        string `gorm:"type:varchar(255)"`
+	Status         string `gorm:"type:varchar(100)"`
+	IsClosed       bool
+	AssignedTo     uint64
+	AssignedToName string `gorm:"type:varchar(255)"`
+	CreatedDate    *time.Time
+	ModifiedDate   *time.Time
+	FinishedDate   *time.Time
+}
+
+func (taigaTask20261019) TableName() string {
+	return "_tool_taiga_tasks"
+}
+
+type taigaStatus20261019 struct {
+	archived.NoPKModel
+	ConnectionId uint64 `gorm:"primaryKey"`
+	ItemType     string `gorm:"primaryKey;type:varchar(20)"`
+	StatusId     uint64 `gorm:"primaryKey;autoIncrement:false"`
+	ProjectId    uint64 `gorm:"index"`
+	Name         string `gorm:"type:varchar(100)"`
+	Slug         string `gorm:"type:varchar(100)"`
+	IsClosed     bool
+	Order        int
+}
+
+func (taigaStatus20261019) TableName() string {
+	return "_tool_taiga_statuses"
+}
+
+type taigaTaskMetric20261019 struct {
+	archived.NoPKModel
+	ConnectionId     uint64 `gorm:"primaryKey"`
+	TaskId           uint64 `gorm:"primaryKey;autoIncrement:false"`
+	ProjectId        uint64 `gorm:"index"`
+	AssignedTo       uint64 `gorm:"index"`
+	InProgressDate   *time.Time
+	ClosedDate       *time.Time
+	CycleTimeMinutes *int64
+}
+
+func (taigaTaskMetric20261019) TableName() string {
+	return "_tool_taiga_task_metrics"
+}
+
+type addTasks struct{}
+
+func (*addTasks) Up(basicRes context.BasicRes) errors.Error {
+	return migrationhelper.AutoMigrateTables(
+		basicRes,
+		&taigaTask20261019{},
+		&taigaStatus20261019{},
+		&taigaTaskMetric20261019{},
+	)
+}
+
+func (*addTasks) Version() uint64 {
+	return 20261019000018
+}
+
+func (*addTasks) Name() string {
+	return "add taiga tasks, statuses and task metrics"
+}
@@ -0,0 +1,110 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package migrationscripts
+
+import (
+	"time"
+
+	"github.com/apache/incubator-devlake/core/context"
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/models/migrationscripts/archived"
+	"github.com/apache/incubator-devlake/helpers/migrationhelper"
+)
+
+type taigaWikiPage20261019 struct {
+	archived.NoPKModel
+	ConnectionId   uint64 `gorm:"primaryKey"`
+	WikiPageId     uint64 `gorm:"primaryKey;autoIncrement:false"`
+	ProjectId      uint64 `gorm:"index"`
+	Slug           string `gorm:"type:varchar(500)"`
+	Version        int
+	Editions       int
+	OwnerId        uint64
+	LastModifierId uint64
+	CreatedDate    *time.Time
+	ModifiedDate   *time.Time
+}
+
+func (taigaWikiPage20261019) TableName() string {
+	return "_tool_taiga_wiki_pages"
+}
+
+type taigaWikiLink20261019 struct {
+	archived.NoPKModel
+	ConnectionId uint64 `gorm:"primaryKey"`
+	WikiLinkId   uint64 `gorm:"primaryKey;autoIncrement:false"`
+	ProjectId    uint64 `gorm:"index"`
+	Title        string `gorm:"type:varchar(500)"`
+	Href         string `gorm:"type:varchar(500)"`
+	Order        int64
+}
+
+func (taigaWikiLink20261019) TableName() string {
+	return "_tool_taiga_wiki_links"
+}
+
+type taigaWikiPageEdit20261019 struct {
+	archived.NoPKModel
+	ConnectionId uint64 `gorm:"primaryKey"`
+	EditId       string `gorm:"primaryKey;type:varchar(100)"`
+	WikiPageId   uint64 `gorm:"index"`
+	ProjectId    uint64 `gorm:"index"`
+	AuthorId     uint64
+	AuthorName   string `gorm:"type:varchar(255)"`
+	EditType     string `gorm:"type:varchar(20)"`
+	CreatedDate  *time.Time
+}
+
+func (taigaWikiPageEdit20261019) TableName() string {
+	return "_tool_taiga_wiki_page_edits"
+}
+
+type taigaDocumentationActivity20261019 struct {
+	archived.DomainEntity
+	BoardId      string    `gorm:"type:varchar(255);index"`
+	PageId       string    `gorm:"type:varchar(255);index"`
+	PageTitle    string    `gorm:"type:varchar(500)"`
+	AuthorId     string    `gorm:"type:varchar(255)"`
+	AuthorName   string    `gorm:"type:varchar(255)"`
+	ActivityType string    `gorm:"type:varchar(20)"`
+	CreatedDate  time.Time `gorm:"index"`
+}
+
+func (taigaDocumentationActivity20261019) TableName() string {
+	return "_tool_taiga_documentation_activities"
+}
+
+type addWiki struct{}
+
+func (*addWiki) Up(basicRes context.BasicRes) errors.Error {
+	return migrationhelper.AutoMigrateTables(
+		basicRes,
+		&taigaWikiPage20261019{},
+		&taigaWikiLink20261019{},
+		&taigaWikiPageEdit20261019{},
+		&taigaDocumentationActivity20261019{},
+	)
+}
+
+func (*addWiki) Version() uint64 {
+	return 20261019000010
+}
+
+func (*addWiki) Name() string {
+	return "add taiga wiki pages and documentation activities"
+}
@@ -0,0 +1,71 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package migrationscripts
+
+import (
+	"slices"
+
+	"github.com/apache/incubator-devlake/core/context"
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/plugin"
+)
+
+type taigaScopeConfigEntities20261019 struct {
+	ID       uint64   `gorm:"primaryKey"`
+	Entities []string `gorm:"type:json;serializer:json"`
+}
+
+func (taigaScopeConfigEntities20261019) TableName() string {
+	return "_tool_taiga_scope_configs"
+}
+
+// enableHistoryEntities keeps collecting the history and comments of the scope configs that list
+// their entities, both used to come with the TICKET domain type
+type enableHistoryEntities struct{}
+
+func (*enableHistoryEntities) Up(basicRes context.BasicRes) errors.Error {
+	db := basicRes.GetDal()
+	var scopeConfigs []taigaScopeConfigEntities20261019
+	err := db.All(&scopeConfigs)
+	if err != nil {
+		return err
+	}
+	for _, scopeConfig := range scopeConfigs {
+		if !slices.Contains(scopeConfig.Entities, plugin.DOMAIN_TYPE_TICKET) {
+			continue
+		}
+		for _, entity := range []string{"TAIGA_HISTORY", "TAIGA_COMMENT"} {
+			if !slices.Contains(scopeConfig.Entities, entity) {
+				scopeConfig.Entities = append(scopeConfig.Entities, entity)
+			}
+		}
+		err = db.Update(&scopeConfig)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (*enableHistoryEntities) Version() uint64 {
+	return 20261019000017
+}
+
+func (*enableHistoryEntities) Name() string {
+	return "enable history and comments on taiga scope configs collecting tickets"
+}
@@ -0,0 +1,62 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"time"
+
+	"github.com/apache/incubator-devlake/core/models/common"
+)
+
+// TaigaMilestone is a sprint of a project
+type TaigaMilestone struct {
+	common.NoPKModel
+	ConnectionId    uint64     `gorm:"primaryKey"`
+	MilestoneId     uint64     `gorm:"primaryKey;autoIncrement:false" json:"id"`
+	ProjectId       uint64     `gorm:"index" json:"projectId"`
+	Name            string     `gorm:"type:varchar(255)" json:"name"`
+	Slug            string     `gorm:"type:varchar(255)" json:"slug"`
+	EstimatedStart  *time.Time `json:"estimatedStart"`
+	EstimatedFinish *time.Time `json:"estimatedFinish"`
+	Closed          bool       `json:"closed"`
+	TotalPoints     float64    `json:"totalPoints"`
+	ClosedPoints    float64    `json:"closedPoints"`
+	CreatedDate     *time.Time `json:"createdDate"`
+	ModifiedDate    *time.Time `json:"modifiedDate"`
+}
+
+func (TaigaMilestone) TableName() string {
+	return "_tool_taiga_milestones"
+}
+
+// TaigaMilestoneBurndown is one day of the burndown chart of a milestone as reported by its stats
+type TaigaMilestoneBurndown struct {
+	common.NoPKModel
+	ConnectionId    uint64    `gorm:"primaryKey"`
+	MilestoneId     uint64    `gorm:"primaryKey;autoIncrement:false" json:"milestoneId"`
+	Day             time.Time `gorm:"primaryKey;type:date" json:"day"`
+	ProjectId       uint64    `gorm:"index" json:"projectId"`
+	TotalPoints     float64   `json:"totalPoints"`
+	OpenPoints      float64   `json:"openPoints"`
+	CompletedPoints float64   `json:"completedPoints"`
+	OptimalPoints   float64   `json:"optimalPoints"`
+}
+
+func (TaigaMilestoneBurndown) TableName() string {
+	return "_tool_taiga_milestone_burndowns"
+}
@@ -35,6 +35,16 @@ type TaigaProject struct {
 	IsPrivate          bool    `json:"isPrivate"`
 	TotalMilestones    int     `json:"totalMilestones"`
 	TotalStoryPoints   float64 `json:"totalStoryPoints"`
+	IsBacklogActivated bool    `json:"isBacklogActivated"`
+	IsKanbanActivated  bool    `json:"isKanbanActivated"`
+}
+
+// BoardType tells how the project is run, kanban when only the kanban module is on and scrum otherwise
+func (p TaigaProject) BoardType() string {
+	if p.IsKanbanActivated && !p.IsBacklogActivated {
+		return "kanban"
+	}
+	return "scrum"
 }
 
 func (p TaigaProject) ScopeId() string {
@@ -0,0 +1,51 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"time"
+
+	"github.com/apache/incubator-devlake/core/models/common"
+)
+
+// TaigaProjectStatsSnapshot is the state of a project's backlog and issues on one day, taken from
+// the project stats and issues_stats of the last collection of that day
+type TaigaProjectStatsSnapshot struct {
+	common.NoPKModel
+	ConnectionId    uint64    `gorm:"primaryKey"`
+	ProjectId       uint64    `gorm:"primaryKey;autoIncrement:false" json:"projectId"`
+	SnapshotDate    time.Time `gorm:"primaryKey;type:date" json:"snapshotDate"`
+	TotalMilestones int       `json:"totalMilestones"`
+	TotalPoints     float64   `json:"totalPoints"`
+	DefinedPoints   float64   `json:"definedPoints"`
+	AssignedPoints  float64   `json:"assignedPoints"`
+	ClosedPoints    float64   `json:"closedPoints"`
+	Speed           float64   `json:"speed"`
+	TotalIssues     int       `json:"totalIssues"`
+	OpenedIssues    int       `json:"openedIssues"`
+	ClosedIssues    int       `json:"closedIssues"`
+	// issue counts keyed by the name of the status, severity, priority or type
+	IssuesPerStatus   map[string]int `gorm:"type:json;serializer:json" json:"issuesPerStatus"`
+	IssuesPerSeverity map[string]int `gorm:"type:json;serializer:json" json:"issuesPerSeverity"`
+	IssuesPerPriority map[string]int `gorm:"type:json;serializer:json" json:"issuesPerPriority"`
+	IssuesPerType     map[string]int `gorm:"type:json;serializer:json" json:"issuesPerType"`
+}
+
+func (TaigaProjectStatsSnapshot) TableName() string {
+	return "_tool_taiga_project_stats_snapshots"
+}
@@ -0,0 +1,44 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBoardType(t *testing.T) {
+	tests := []struct {
+		name    string
+		backlog bool
+		kanban  bool
+		want    string
+	}{
+		{"backlog only", true, false, "scrum"},
+		{"kanban only", false, true, "kanban"},
+		{"both modules", true, true, "scrum"},
+		{"neither module", false, false, "scrum"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			project := TaigaProject{IsBacklogActivated: tt.backlog, IsKanbanActivated: tt.kanban}
+			assert.Equal(t, tt.want, project.BoardType())
+		})
+	}
+}
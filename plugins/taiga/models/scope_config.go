@@ -18,6 +18,10 @@ limitations under the License.
 package models
 
 import (
+	"fmt"
+	"regexp"
+	"time"
+
 	"github.com/apache/incubator-devlake/core/errors"
 	"github.com/apache/incubator-devlake/core/models/common"
 )
@@ -33,9 +37,66 @@ type TypeMapping struct {
 	StatusMappings StatusMappings `json:"statusMappings"`
 }
 
+// Taiga specific entities, list them in Entities next to the DevLake domain types to turn on the
+// optional subtasks that are too expensive to run for every project
+const (
+	ENTITY_TYPE_ATTACHMENT = "TAIGA_ATTACHMENT"
+	ENTITY_TYPE_WIKI       = "TAIGA_WIKI"
+	// ENTITY_TYPE_HISTORY turns on the history of user stories and tasks, converted into changelogs,
+	// blocked periods and task metrics, it takes one request per item
+	ENTITY_TYPE_HISTORY = "TAIGA_HISTORY"
+	// ENTITY_TYPE_COMMENT turns on the comments of user stories and tasks, they come with the history
+	ENTITY_TYPE_COMMENT = "TAIGA_COMMENT"
+)
+
+// ENTITY_TYPES are the Taiga specific entities
+var ENTITY_TYPES = []string{ENTITY_TYPE_ATTACHMENT, ENTITY_TYPE_WIKI, ENTITY_TYPE_HISTORY, ENTITY_TYPE_COMMENT}
+
+// Issue fields a custom attribute can be mapped onto
+const (
+	IssueFieldComponent               = "component"
+	IssueFieldSeverity                = "severity"
+	IssueFieldPriority                = "priority"
+	IssueFieldOriginalEstimateMinutes = "originalEstimateMinutes"
+	IssueFieldTimeRemainingMinutes    = "timeRemainingMinutes"
+	IssueFieldTimeSpentMinutes        = "timeSpentMinutes"
+	// IssueFieldDuplicateOf holds the ref of the item a story or task duplicates, e.g. #12, it becomes
+	// a duplicate relationship instead of an issue field
+	IssueFieldDuplicateOf = "duplicateOf"
+)
+
+// Units of custom attributes mapped onto time fields
+const (
+	TimeUnitMinutes = "minutes"
+	TimeUnitHours   = "hours"
+	TimeUnitDays    = "days"
+	// DefaultHoursPerDay is the length of a working day when a mapping does not give one
+	DefaultHoursPerDay = 8
+)
+
+// CustomAttributeMapping copies the value of a Taiga custom attribute onto an issue field
+type CustomAttributeMapping struct {
+	// Attribute is the name of the custom attribute as shown in Taiga
+	Attribute string `json:"attribute"`
+	// Unit is only used for time fields, defaults to minutes
+	Unit string `json:"unit"`
+	// HoursPerDay is the length of a day in the days unit, defaults to DefaultHoursPerDay
+	HoursPerDay float64 `json:"hoursPerDay"`
+}
+
 type TaigaScopeConfig struct {
 	common.ScopeConfig `mapstructure:",squash" json:",inline" gorm:"embedded"`
 	TypeMappings       map[string]TypeMapping `mapstructure:"typeMappings,omitempty" json:"typeMappings" gorm:"type:json;serializer:json"`
+	// CustomAttributeMappings is keyed by issue field, e.g. component or originalEstimateMinutes
+	CustomAttributeMappings map[string]CustomAttributeMapping `mapstructure:"customAttributeMappings,omitempty" json:"customAttributeMappings" gorm:"type:json;serializer:json"`
+	// StoryPointRole is the name of the role whose estimate becomes the story point, empty uses the total points
+	StoryPointRole string `mapstructure:"storyPointRole,omitempty" json:"storyPointRole" gorm:"type:varchar(255)"`
+	// regular expressions matched against the tags of an item to derive its standard issue type
+	IssueTypeRequirement string `mapstructure:"issueTypeRequirement,omitempty" json:"issueTypeRequirement" gorm:"type:varchar(255)"`
+	IssueTypeBug         string `mapstructure:"issueTypeBug,omitempty" json:"issueTypeBug" gorm:"type:varchar(255)"`
+	IssueTypeIncident    string `mapstructure:"issueTypeIncident,omitempty" json:"issueTypeIncident" gorm:"type:varchar(255)"`
+	// TimeAfter limits collection and conversion to items created or modified since then
+	TimeAfter *time.Time `mapstructure:"timeAfter,omitempty" json:"timeAfter"`
 }
 
 func (r *TaigaScopeConfig) SetConnectionId(c *TaigaScopeConfig, connectionId uint64) {
@@ -44,7 +105,30 @@ func (r *TaigaScopeConfig) SetConnectionId(c *TaigaScopeConfig, connectionId uin
 }
 
 func (r *TaigaScopeConfig) Validate() errors.Error {
-	// Add validation logic if needed
+	for field, mapping := range r.CustomAttributeMappings {
+		switch field {
+		case IssueFieldComponent, IssueFieldSeverity, IssueFieldPriority, IssueFieldDuplicateOf:
+		case IssueFieldOriginalEstimateMinutes, IssueFieldTimeRemainingMinutes, IssueFieldTimeSpentMinutes:
+			switch mapping.Unit {
+			case "", TimeUnitMinutes, TimeUnitHours, TimeUnitDays:
+			default:
+				return errors.BadInput.New(fmt.Sprintf("unknown unit %s for %s", mapping.Unit, field))
+			}
+			if mapping.HoursPerDay < 0 || mapping.HoursPerDay > 24 {
+				return errors.BadInput.New(fmt.Sprintf("hoursPerDay of %s must be between 0 and 24", field))
+			}
+		default:
+			return errors.BadInput.New(fmt.Sprintf("custom attributes cannot be mapped onto %s", field))
+		}
+		if mapping.Attribute == "" {
+			return errors.BadInput.New(fmt.Sprintf("no custom attribute given for %s", field))
+		}
+	}
+	for _, pattern := range []string{r.IssueTypeRequirement, r.IssueTypeBug, r.IssueTypeIncident} {
+		if _, err := regexp.Compile(pattern); err != nil {
+			return errors.BadInput.Wrap(err, fmt.Sprintf("invalid issue type pattern %s", pattern))
+		}
+	}
 	return nil
 }
 
@@ -0,0 +1,83 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"time"
+
+	"github.com/apache/incubator-devlake/core/models/common"
+)
+
+// TaigaTask is a task of a project, usually broken out of a user story
+type TaigaTask struct {
+	common.NoPKModel
+	ConnectionId   uint64     `gorm:"primaryKey"`
+	TaskId         uint64     `gorm:"primaryKey;autoIncrement:false" json:"id"`
+	ProjectId      uint64     `gorm:"index" json:"projectId"`
+	UserStoryId    uint64     `json:"userStoryId"`
+	MilestoneId    uint64     `json:"milestoneId"`
+	Ref            int        `json:"ref"`
+	Subject        string     `gorm:"type:varchar(255)" json:"subject"`
+	Status         string     `gorm:"type:varchar(100)" json:"status"`
+	IsClosed       bool       `json:"isClosed"`
+	AssignedTo     uint64     `json:"assignedTo"`
+	AssignedToName string     `gorm:"type:varchar(255)" json:"assignedToName"`
+	CreatedDate    *time.Time `json:"createdDate"`
+	ModifiedDate   *time.Time `json:"modifiedDate"`
+	FinishedDate   *time.Time `json:"finishedDate"`
+}
+
+func (TaigaTask) TableName() string {
+	return "_tool_taiga_tasks"
+}
+
+// TaigaStatus is a status of the workflow a project defines for one item type
+type TaigaStatus struct {
+	common.NoPKModel
+	ConnectionId uint64 `gorm:"primaryKey"`
+	ItemType     string `gorm:"primaryKey;type:varchar(20)" json:"itemType"`
+	StatusId     uint64 `gorm:"primaryKey;autoIncrement:false" json:"id"`
+	ProjectId    uint64 `gorm:"index" json:"projectId"`
+	Name         string `gorm:"type:varchar(100)" json:"name"`
+	Slug         string `gorm:"type:varchar(100)" json:"slug"`
+	IsClosed     bool   `json:"isClosed"`
+	Order        int    `json:"order"`
+}
+
+func (TaigaStatus) TableName() string {
+	return "_tool_taiga_statuses"
+}
+
+// TaigaTaskMetric holds when work on a task started and when it was done, derived from the
+// status changes in its history
+type TaigaTaskMetric struct {
+	common.NoPKModel
+	ConnectionId   uint64     `gorm:"primaryKey"`
+	TaskId         uint64     `gorm:"primaryKey;autoIncrement:false" json:"taskId"`
+	ProjectId      uint64     `gorm:"index" json:"projectId"`
+	AssignedTo     uint64     `gorm:"index" json:"assignedTo"`
+	InProgressDate *time.Time `json:"inProgressDate"`
+	ClosedDate     *time.Time `json:"closedDate"`
+	// CycleTimeMinutes goes from the first move to an in progress status to the last move to a
+	// done status, it is nil for tasks that are still open or skipped the in progress statuses
+	CycleTimeMinutes *int64 `json:"cycleTimeMinutes"`
+}
+
+func (TaigaTaskMetric) TableName() string {
+	return "_tool_taiga_task_metrics"
+}
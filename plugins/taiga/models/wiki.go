@@ -0,0 +1,75 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"time"
+
+	"github.com/apache/incubator-devlake/core/models/common"
+)
+
+// TaigaWikiPage is a wiki page of a project, only collected when the TAIGA_WIKI entity is enabled
+type TaigaWikiPage struct {
+	common.NoPKModel
+	ConnectionId   uint64     `gorm:"primaryKey"`
+	WikiPageId     uint64     `gorm:"primaryKey;autoIncrement:false" json:"id"`
+	ProjectId      uint64     `gorm:"index" json:"projectId"`
+	Slug           string     `gorm:"type:varchar(500)" json:"slug"`
+	Version        int        `json:"version"`
+	Editions       int        `json:"editions"`
+	OwnerId        uint64     `json:"ownerId"`
+	LastModifierId uint64     `json:"lastModifierId"`
+	CreatedDate    *time.Time `json:"createdDate"`
+	ModifiedDate   *time.Time `json:"modifiedDate"`
+}
+
+func (TaigaWikiPage) TableName() string {
+	return "_tool_taiga_wiki_pages"
+}
+
+// TaigaWikiLink is an entry of the wiki sidebar of a project
+type TaigaWikiLink struct {
+	common.NoPKModel
+	ConnectionId uint64 `gorm:"primaryKey"`
+	WikiLinkId   uint64 `gorm:"primaryKey;autoIncrement:false" json:"id"`
+	ProjectId    uint64 `gorm:"index" json:"projectId"`
+	Title        string `gorm:"type:varchar(500)" json:"title"`
+	Href         string `gorm:"type:varchar(500)" json:"href"`
+	Order        int64  `json:"order"`
+}
+
+func (TaigaWikiLink) TableName() string {
+	return "_tool_taiga_wiki_links"
+}
+
+// TaigaWikiPageEdit is a creation or change of a wiki page taken from its history
+type TaigaWikiPageEdit struct {
+	common.NoPKModel
+	ConnectionId uint64     `gorm:"primaryKey"`
+	EditId       string     `gorm:"primaryKey;type:varchar(100)" json:"id"`
+	WikiPageId   uint64     `gorm:"index" json:"wikiPageId"`
+	ProjectId    uint64     `gorm:"index" json:"projectId"`
+	AuthorId     uint64     `json:"authorId"`
+	AuthorName   string     `gorm:"type:varchar(255)" json:"authorName"`
+	EditType     string     `gorm:"type:varchar(20)" json:"editType"`
+	CreatedDate  *time.Time `json:"createdDate"`
+}
+
+func (TaigaWikiPageEdit) TableName() string {
+	return "_tool_taiga_wiki_page_edits"
+}
@@ -0,0 +1,82 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/plugin"
+	"github.com/apache/incubator-devlake/helpers/pluginhelper/api"
+)
+
+const RAW_ACCOUNT_TABLE = "taiga_api_accounts"
+
+var _ plugin.SubTaskEntryPoint = CollectAccounts
+
+var CollectAccountsMeta = plugin.SubTaskMeta{
+	Name:             "collectAccounts",
+	EntryPoint:       skipOnReplay(CollectAccounts),
+	EnabledByDefault: true,
+	Description:      "collect Taiga users of the project, or of the connection when no project is given",
+	DomainTypes:      []string{plugin.DOMAIN_TYPE_CROSS},
+}
+
+func CollectAccounts(taskCtx plugin.SubTaskContext) errors.Error {
+	data := taskCtx.GetData().(*TaigaTaskData)
+	logger := taskCtx.GetLogger()
+	logger.Info("collect accounts")
+
+	collector, err := api.NewApiCollector(api.ApiCollectorArgs{
+		RawDataSubTaskArgs: api.RawDataSubTaskArgs{
+			Ctx: taskCtx,
+			Params: TaigaApiParams{
+				ConnectionId: data.Options.ConnectionId,
+				ProjectId:    data.Options.ProjectId,
+			},
+			Table: RAW_ACCOUNT_TABLE,
+		},
+		ApiClient:     data.ApiClient,
+		AfterResponse: withThrottling(data, nil),
+		UrlTemplate:   "users",
+		Query: func(reqData *api.RequestData) (url.Values, errors.Error) {
+			query := url.Values{}
+			// without a project Taiga lists every user sharing a project with the token owner
+			if data.Options.ProjectId != 0 {
+				query.Set("project", fmt.Sprintf("%d", data.Options.ProjectId))
+			}
+			return query, nil
+		},
+		ResponseParser: func(res *http.Response) ([]json.RawMessage, errors.Error) {
+			var result []json.RawMessage
+			err := api.UnmarshalResponse(res, &result)
+			if err != nil {
+				return nil, err
+			}
+			return result, nil
+		},
+	})
+	if err != nil {
+		logger.Error(err, "collect accounts error")
+		return err
+	}
+	return collector.Execute()
+}
@@ -0,0 +1,84 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"encoding/json"
+
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/plugin"
+	"github.com/apache/incubator-devlake/helpers/pluginhelper/api"
+	"github.com/irfanuddinahmad/taiga-devlake-plugin/plugins/taiga/models"
+)
+
+var _ plugin.SubTaskEntryPoint = ExtractAccounts
+
+var ExtractAccountsMeta = plugin.SubTaskMeta{
+	Name:             "extractAccounts",
+	EntryPoint:       ExtractAccounts,
+	EnabledByDefault: true,
+	Description:      "extract Taiga users",
+	DomainTypes:      []string{plugin.DOMAIN_TYPE_CROSS},
+	Dependencies:     []*plugin.SubTaskMeta{&CollectAccountsMeta},
+}
+
+func ExtractAccounts(taskCtx plugin.SubTaskContext) errors.Error {
+	data := taskCtx.GetData().(*TaigaTaskData)
+	extractor, err := api.NewApiExtractor(api.ApiExtractorArgs{
+		RawDataSubTaskArgs: api.RawDataSubTaskArgs{
+			Ctx: taskCtx,
+			Params: TaigaApiParams{
+				ConnectionId: data.Options.ConnectionId,
+				ProjectId:    data.Options.ProjectId,
+			},
+			Table: RAW_ACCOUNT_TABLE,
+		},
+		Extract: func(row *api.RawData) ([]interface{}, errors.Error) {
+			var apiUser struct {
+				Id       uint64 `json:"id"`
+				Username string `json:"username"`
+				FullName string `json:"full_name_display"`
+				Email    string `json:"email"`
+				Photo    string `json:"photo"`
+				IsActive bool   `json:"is_active"`
+			}
+			err := json.Unmarshal(row.Data, &apiUser)
+			if err != nil {
+				return nil, errors.Default.Wrap(err, "error unmarshalling user")
+			}
+
+			account := &models.TaigaAccount{
+				ConnectionId: data.Options.ConnectionId,
+				AccountId:    apiUser.Id,
+				Username:     apiUser.Username,
+				FullName:     apiUser.FullName,
+				Email:        apiUser.Email,
+				AvatarUrl:    apiUser.Photo,
+				IsActive:     apiUser.IsActive,
+			}
+
+			return []interface{}{account}, nil
+		},
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return extractor.Execute()
+}
@@ -18,42 +18,266 @@ limitations under the License.
 package tasks
 
 import (
+	"context"
 	"net/http"
+	"strconv"
+	"sync"
+	"time"
 
 	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/log"
 	"github.com/apache/incubator-devlake/core/plugin"
 	"github.com/apache/incubator-devlake/helpers/pluginhelper/api"
 	"github.com/irfanuddinahmad/taiga-devlake-plugin/plugins/taiga/models"
 )
 
-func NewTaigaApiClient(taskCtx plugin.TaskContext, connection *models.TaigaConnection) (*api.ApiAsyncClient, errors.Error) {
-	// create synchronize api client
+const (
+	// defaultRetryAfter is used when Taiga throttles without telling us for how long
+	defaultRetryAfter = 10 * time.Second
+	maxRetryAfter     = 5 * time.Minute
+	// throttled requests are spaced at least minThrottleInterval apart, doubling on every throttle event
+	minThrottleInterval = 200 * time.Millisecond
+	maxThrottleInterval = 10 * time.Second
+)
+
+// NewTaigaApiClient creates the async api client of a task and the throttle shared by all of its
+// collectors, see withThrottling
+func NewTaigaApiClient(taskCtx plugin.TaskContext, connection *models.TaigaConnection) (*api.ApiAsyncClient, *TaigaThrottle, errors.Error) {
+	// create synchronize api client, the api prefix, proxy and TLS settings are taken from the connection
 	apiClient, err := api.NewApiClientFromConnection(taskCtx.GetContext(), taskCtx, connection)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+
+	// back off when Taiga throttles us, the authentication set up by the connection runs after the wait
+	throttle := &TaigaThrottle{logger: taskCtx.GetLogger(), released: make(chan struct{})}
+	authenticate := apiClient.GetBeforeFunction()
+	apiClient.SetBeforeFunction(func(req *http.Request) errors.Error {
+		if err := throttle.beforeRequest(req); err != nil {
+			return err
+		}
+		if authenticate != nil {
+			return authenticate(req)
+		}
+		return nil
+	})
+	// requests outside of collectors keep the throttle handling, collectors install withThrottling
+	apiClient.SetAfterFunction(throttle.afterResponse)
+	// the concurrency limit is enforced around the round trip, so failed requests give their slot back
+	if client, ok := interface{}(apiClient).(interface{ GetClient() *http.Client }); ok {
+		client.GetClient().Transport = &throttledTransport{base: client.GetClient().Transport, throttle: throttle}
+	} else {
+		taskCtx.GetLogger().Warn(nil, "the api client does not expose its http client, throttling only spaces requests out")
 	}
 
 	// create rate limit calculator
 	rateLimiter := &api.ApiRateLimitCalculator{
 		UserRateLimitPerHour: connection.RateLimitPerHour,
 	}
-	
+
 	asyncApiClient, err := api.CreateAsyncApiClient(
 		taskCtx,
 		apiClient,
 		rateLimiter,
 	)
 	if err != nil {
+		return nil, nil, err
+	}
+	throttle.maxConcurrency = asyncApiClient.GetNumOfWorkers()
+
+	return asyncApiClient, throttle, nil
+}
+
+// TaigaThrottle spaces out the requests of a task and lowers the number of concurrent requests once
+// Taiga answers with 429 or 503, so long syncs slow down instead of exhausting their retries
+type TaigaThrottle struct {
+	logger   log.Logger
+	mu       sync.Mutex
+	interval time.Duration
+	next     time.Time
+	events   int
+	// maxConcurrency is the number of workers of the async client, throttling halves the
+	// concurrency from there and every successful response gives one slot back
+	maxConcurrency int
+	// concurrency is the number of requests allowed in flight, 0 means no limit
+	concurrency int
+	inFlight    int
+	// released is closed and replaced whenever a slot may have become free, waiting requests
+	// select on it next to their context
+	released chan struct{}
+}
+
+func (t *TaigaThrottle) beforeRequest(req *http.Request) errors.Error {
+	t.mu.Lock()
+	start := time.Now()
+	if t.next.After(start) {
+		start = t.next
+	}
+	if t.interval > 0 {
+		t.next = start.Add(t.interval)
+	}
+	t.mu.Unlock()
+
+	wait := time.Until(start)
+	if wait <= 0 {
+		return nil
+	}
+	select {
+	case <-time.After(wait):
+		return nil
+	case <-req.Context().Done():
+		return errors.Convert(req.Context().Err())
+	}
+}
+
+func (t *TaigaThrottle) afterResponse(res *http.Response) errors.Error {
+	if res.StatusCode != http.StatusTooManyRequests && res.StatusCode != http.StatusServiceUnavailable {
+		t.relax()
+		return nil
+	}
+	retryAfter := parseRetryAfter(res.Header.Get("Retry-After"))
+
+	t.mu.Lock()
+	t.events++
+	events := t.events
+	t.interval *= 2
+	if t.interval < minThrottleInterval {
+		t.interval = minThrottleInterval
+	}
+	if t.interval > maxThrottleInterval {
+		t.interval = maxThrottleInterval
+	}
+	interval := t.interval
+	if resume := time.Now().Add(retryAfter); resume.After(t.next) {
+		t.next = resume
+	}
+	if t.concurrency == 0 {
+		t.concurrency = t.maxConcurrency
+	}
+	t.concurrency /= 2
+	if t.concurrency < 1 {
+		t.concurrency = 1
+	}
+	concurrency := t.concurrency
+	t.mu.Unlock()
+
+	t.logger.Warn(nil, "throttled by taiga (event %d): %d on %s, pausing %s, spacing requests %s apart and sending at most %d at a time",
+		events, res.StatusCode, res.Request.URL.Path, retryAfter, interval, concurrency)
+	// returning an error makes the async client retry the request, which waits in beforeRequest
+	return errors.HttpStatus(res.StatusCode).New("throttled by taiga")
+}
+
+// relax shortens the interval and raises the concurrency after every successful response until
+// requests are no longer spaced nor limited
+func (t *TaigaThrottle) relax() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.interval == 0 && t.concurrency == 0 {
+		return
+	}
+	t.interval = t.interval * 9 / 10
+	if t.interval < minThrottleInterval {
+		t.interval = 0
+	}
+	if t.concurrency > 0 {
+		t.concurrency++
+		if t.concurrency >= t.maxConcurrency {
+			t.concurrency = 0
+		}
+		t.broadcast()
+	}
+	if t.interval == 0 && t.concurrency == 0 {
+		t.logger.Info("taiga stopped throttling after %d events, resuming full speed", t.events)
+	}
+}
+
+// acquire blocks until the request fits into the current concurrency limit or its context is done
+func (t *TaigaThrottle) acquire(ctx context.Context) error {
+	t.mu.Lock()
+	for t.concurrency > 0 && t.inFlight >= t.concurrency {
+		released := t.released
+		t.mu.Unlock()
+		select {
+		case <-released:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		t.mu.Lock()
+	}
+	t.inFlight++
+	t.mu.Unlock()
+	return nil
+}
+
+func (t *TaigaThrottle) release() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.inFlight--
+	t.broadcast()
+}
+
+// broadcast wakes up the waiting requests, the caller holds mu
+func (t *TaigaThrottle) broadcast() {
+	close(t.released)
+	t.released = make(chan struct{})
+}
+
+// throttledTransport holds every round trip to the concurrency limit of the throttle
+type throttledTransport struct {
+	base     http.RoundTripper
+	throttle *TaigaThrottle
+}
+
+func (t *throttledTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	if err := t.throttle.acquire(req.Context()); err != nil {
 		return nil, err
 	}
+	defer t.throttle.release()
+	return base.RoundTrip(req)
+}
 
-	return asyncApiClient, nil
+// parseRetryAfter supports both forms of the header, delay-seconds and HTTP-date
+func parseRetryAfter(value string) time.Duration {
+	retryAfter := defaultRetryAfter
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		retryAfter = time.Duration(seconds) * time.Second
+	} else if date, err := http.ParseTime(value); err == nil {
+		retryAfter = time.Until(date)
+	}
+	if retryAfter < 0 {
+		retryAfter = 0
+	}
+	if retryAfter > maxRetryAfter {
+		retryAfter = maxRetryAfter
+	}
+	return retryAfter
 }
 
-func ignoreHTTPStatus404(res *http.Response) errors.Error {
-	if res.StatusCode == http.StatusUnauthorized {
-		return errors.Unauthorized.New("authentication failed, please check your Bearer Token")
+// withThrottling composes the throttle of the task with the AfterResponse of a collector, which
+// replaces the handler installed by NewTaigaApiClient on the shared client. A rejected token fails
+// the collector whatever the handler, which may be nil
+func withThrottling(data *TaigaTaskData, handler plugin.ApiClientAfterResponse) plugin.ApiClientAfterResponse {
+	return func(res *http.Response) errors.Error {
+		if data.Throttle != nil {
+			if err := data.Throttle.afterResponse(res); err != nil {
+				return err
+			}
+		}
+		if res.StatusCode == http.StatusUnauthorized {
+			return errors.Unauthorized.New("authentication failed, please check your Bearer Token")
+		}
+		if handler == nil {
+			return nil
+		}
+		return handler(res)
 	}
+}
+
+func ignoreHTTPStatus404(res *http.Response) errors.Error {
 	if res.StatusCode == http.StatusNotFound {
 		return api.ErrIgnoreAndContinue
 	}
@@ -0,0 +1,162 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/apache/incubator-devlake/helpers/pluginhelper/api"
+	"github.com/apache/incubator-devlake/helpers/unithelper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseRetryAfter(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  time.Duration
+	}{
+		{"missing header", "", defaultRetryAfter},
+		{"delay seconds", "30", 30 * time.Second},
+		{"zero", "0", 0},
+		{"negative seconds fall back to the default", "-5", defaultRetryAfter},
+		{"garbage", "soon", defaultRetryAfter},
+		{"capped", "3600", maxRetryAfter},
+		{"date in the past", time.Now().Add(-time.Hour).UTC().Format(http.TimeFormat), 0},
+		{"date far ahead is capped", time.Now().Add(time.Hour).UTC().Format(http.TimeFormat), maxRetryAfter},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, parseRetryAfter(tt.value))
+		})
+	}
+}
+
+func newTestThrottle(maxConcurrency int) *TaigaThrottle {
+	return &TaigaThrottle{logger: unithelper.DummyLogger(), maxConcurrency: maxConcurrency, released: make(chan struct{})}
+}
+
+func newTestResponse(statusCode int) *http.Response {
+	return &http.Response{
+		StatusCode: statusCode,
+		Header:     http.Header{"Retry-After": []string{"0"}},
+		Request:    &http.Request{URL: &url.URL{Path: "/api/v1/userstories"}},
+	}
+}
+
+func TestTaigaThrottleConcurrency(t *testing.T) {
+	throttle := newTestThrottle(8)
+
+	assert.NotNil(t, throttle.afterResponse(newTestResponse(http.StatusTooManyRequests)))
+	assert.Equal(t, 4, throttle.concurrency)
+	assert.Equal(t, minThrottleInterval, throttle.interval)
+
+	assert.NotNil(t, throttle.afterResponse(newTestResponse(http.StatusServiceUnavailable)))
+	assert.Equal(t, 2, throttle.concurrency)
+	assert.NotNil(t, throttle.afterResponse(newTestResponse(http.StatusTooManyRequests)))
+	assert.NotNil(t, throttle.afterResponse(newTestResponse(http.StatusTooManyRequests)))
+	assert.Equal(t, 1, throttle.concurrency)
+
+	// successful responses give the slots back one by one until the limit is lifted
+	for i := 0; i < 6; i++ {
+		assert.Nil(t, throttle.afterResponse(newTestResponse(http.StatusOK)))
+	}
+	assert.Equal(t, 7, throttle.concurrency)
+	assert.Nil(t, throttle.afterResponse(newTestResponse(http.StatusOK)))
+	assert.Equal(t, 0, throttle.concurrency)
+}
+
+func TestTaigaThrottleAcquire(t *testing.T) {
+	throttle := newTestThrottle(4)
+	throttle.concurrency = 1
+	assert.Nil(t, throttle.acquire(context.Background()))
+
+	acquired := make(chan struct{})
+	go func() {
+		assert.Nil(t, throttle.acquire(context.Background()))
+		close(acquired)
+	}()
+	select {
+	case <-acquired:
+		t.Fatal("a second request went out while the concurrency is limited to one")
+	case <-time.After(50 * time.Millisecond):
+	}
+	throttle.release()
+	select {
+	case <-acquired:
+	case <-time.After(time.Second):
+		t.Fatal("the waiting request was not released")
+	}
+	throttle.release()
+	assert.Equal(t, 0, throttle.inFlight)
+}
+
+func TestTaigaThrottleAcquireCanceled(t *testing.T) {
+	throttle := newTestThrottle(4)
+	throttle.concurrency = 1
+	assert.Nil(t, throttle.acquire(context.Background()))
+
+	// a canceled request stops waiting for a slot and does not take one
+	ctx, cancel := context.WithCancel(context.Background())
+	canceled := make(chan error)
+	go func() {
+		canceled <- throttle.acquire(ctx)
+	}()
+	cancel()
+	select {
+	case err := <-canceled:
+		assert.Equal(t, context.Canceled, err)
+	case <-time.After(time.Second):
+		t.Fatal("the canceled request kept waiting for a slot")
+	}
+	throttle.release()
+	assert.Equal(t, 0, throttle.inFlight)
+}
+
+func TestWithThrottlingDoesNotChainHandlers(t *testing.T) {
+	throttle := newTestThrottle(4)
+	data := &TaigaTaskData{Throttle: throttle}
+
+	// the first collector ignores 404, the next one must not inherit it
+	first := withThrottling(data, ignoreHTTPStatus404)
+	second := withThrottling(data, nil)
+	assert.Equal(t, api.ErrIgnoreAndContinue, first(newTestResponse(http.StatusNotFound)))
+	assert.Nil(t, second(newTestResponse(http.StatusNotFound)))
+
+	// a throttled response is counted once whatever the number of collectors built before
+	for i := 0; i < 5; i++ {
+		withThrottling(data, ignoreHTTPStatus404)
+	}
+	err := second(newTestResponse(http.StatusTooManyRequests))
+	assert.NotNil(t, err)
+	assert.Equal(t, 1, throttle.events)
+
+	err = second(newTestResponse(http.StatusUnauthorized))
+	assert.NotNil(t, err)
+	assert.NotEqual(t, api.ErrIgnoreAndContinue, err)
+}
+
+func TestWithThrottlingWithoutThrottle(t *testing.T) {
+	handler := withThrottling(&TaigaTaskData{}, ignoreHTTPStatus400)
+	assert.Nil(t, handler(newTestResponse(http.StatusOK)))
+	assert.Equal(t, api.ErrIgnoreAndContinue, handler(newTestResponse(http.StatusBadRequest)))
+}
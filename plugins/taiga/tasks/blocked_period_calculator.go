@@ -0,0 +1,172 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"time"
+
+	"github.com/apache/incubator-devlake/core/dal"
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/plugin"
+	"github.com/irfanuddinahmad/taiga-devlake-plugin/plugins/taiga/models"
+)
+
+var _ plugin.SubTaskEntryPoint = CalculateBlockedPeriods
+
+var CalculateBlockedPeriodsMeta = plugin.SubTaskMeta{
+	Name:             "calculateBlockedPeriods",
+	EntryPoint:       CalculateBlockedPeriods,
+	EnabledByDefault: true,
+	Description:      "calculate blocked periods of Taiga user stories from the is_blocked changes in their history",
+	DomainTypes:      []string{models.ENTITY_TYPE_HISTORY},
+	Dependencies:     []*plugin.SubTaskMeta{&ExtractUserStoryHistoriesMeta},
+}
+
+const (
+	fieldIsBlocked   = "is_blocked"
+	fieldBlockedNote = "blocked_note"
+)
+
+// CalculateBlockedPeriods rebuilds the blocked periods of the user stories of a project and
+// stores the total blocked minutes of every story in TaigaUserStory.BlockedMinutes
+func CalculateBlockedPeriods(taskCtx plugin.SubTaskContext) errors.Error {
+	data := taskCtx.GetData().(*TaigaTaskData)
+	logger := taskCtx.GetLogger()
+	db := taskCtx.GetDal()
+	logger.Info("calculate blocked periods of project:%d", data.Options.ProjectId)
+
+	var changelogs []models.TaigaIssueChangelog
+	err := db.All(
+		&changelogs,
+		dal.Where("connection_id = ? AND project_id = ? AND item_type = ? AND field_name IN ?",
+			data.Options.ConnectionId, data.Options.ProjectId, models.ItemTypeUserStory,
+			[]string{fieldIsBlocked, fieldBlockedNote}),
+		dal.Orderby("item_id, created_date, changelog_id"),
+	)
+	if err != nil {
+		return err
+	}
+	var blockedStories []models.TaigaUserStory
+	err = db.All(
+		&blockedStories,
+		dal.Where("connection_id = ? AND project_id = ? AND is_blocked = ?",
+			data.Options.ConnectionId, data.Options.ProjectId, true),
+	)
+	if err != nil {
+		return err
+	}
+	blockedNotes := make(map[uint64]string, len(blockedStories))
+	for _, story := range blockedStories {
+		blockedNotes[story.UserStoryId] = story.BlockedNote
+	}
+
+	err = db.Delete(
+		&models.TaigaBlockedPeriod{},
+		dal.Where("connection_id = ? AND project_id = ? AND item_type = ?",
+			data.Options.ConnectionId, data.Options.ProjectId, models.ItemTypeUserStory),
+	)
+	if err != nil {
+		return err
+	}
+	err = db.UpdateColumn(
+		&models.TaigaUserStory{}, "blocked_minutes", 0,
+		dal.Where("connection_id = ? AND project_id = ?", data.Options.ConnectionId, data.Options.ProjectId),
+	)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	totals := make(map[uint64]int64)
+	for start := 0; start < len(changelogs); {
+		end := start
+		for end < len(changelogs) && changelogs[end].ItemId == changelogs[start].ItemId {
+			end++
+		}
+		itemId := changelogs[start].ItemId
+		for _, period := range blockedPeriods(changelogs[start:end], blockedNotes[itemId], now) {
+			period.ConnectionId = data.Options.ConnectionId
+			period.ProjectId = data.Options.ProjectId
+			period.ItemType = models.ItemTypeUserStory
+			period.ItemId = itemId
+			if err = db.CreateOrUpdate(period); err != nil {
+				return err
+			}
+			totals[itemId] += period.BlockedMinutes
+		}
+		start = end
+	}
+	for itemId, minutes := range totals {
+		err = db.UpdateColumn(
+			&models.TaigaUserStory{}, "blocked_minutes", minutes,
+			dal.Where("connection_id = ? AND user_story_id = ?", data.Options.ConnectionId, itemId),
+		)
+		if err != nil {
+			return err
+		}
+	}
+	logger.Info("calculated blocked time of %d user stories", len(totals))
+	return nil
+}
+
+// blockedPeriods walks the is_blocked and blocked_note changes of one item in chronological order,
+// a period still open at the end is counted up to now and falls back to currentNote for its reason
+func blockedPeriods(changelogs []models.TaigaIssueChangelog, currentNote string, now time.Time) []*models.TaigaBlockedPeriod {
+	var periods []*models.TaigaBlockedPeriod
+	var open *models.TaigaBlockedPeriod
+	note := ""
+	for i := 0; i < len(changelogs); {
+		// the note is usually set in the same history entry that blocks the item
+		entryId := changelogs[i].ChangelogId
+		blocked := ""
+		var at *time.Time
+		for ; i < len(changelogs) && changelogs[i].ChangelogId == entryId; i++ {
+			at = changelogs[i].CreatedDate
+			switch changelogs[i].FieldName {
+			case fieldBlockedNote:
+				note = changelogs[i].ToValue
+			case fieldIsBlocked:
+				blocked = changelogs[i].ToValue
+			}
+		}
+		if at == nil {
+			continue
+		}
+		if open != nil && open.Reason == "" {
+			open.Reason = note
+		}
+		switch {
+		case blocked == "true" && open == nil:
+			open = &models.TaigaBlockedPeriod{StartDate: *at, Reason: note}
+		case blocked == "false" && open != nil:
+			endDate := *at
+			open.EndDate = &endDate
+			open.BlockedMinutes = int64(endDate.Sub(open.StartDate).Minutes())
+			periods = append(periods, open)
+			open = nil
+		}
+	}
+	if open != nil {
+		if open.Reason == "" {
+			open.Reason = currentNote
+		}
+		open.BlockedMinutes = int64(now.Sub(open.StartDate).Minutes())
+		periods = append(periods, open)
+	}
+	return periods
+}
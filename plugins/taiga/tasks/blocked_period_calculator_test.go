@@ -0,0 +1,116 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"testing"
+	"time"
+
+	"github.com/irfanuddinahmad/taiga-devlake-plugin/plugins/taiga/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlockedPeriods(t *testing.T) {
+	change := func(entryId, at, field, to string) models.TaigaIssueChangelog {
+		return models.TaigaIssueChangelog{ChangelogId: entryId, FieldName: field, ToValue: to, CreatedDate: timePtr(at)}
+	}
+	period := func(start, end, reason string, minutes int64) *models.TaigaBlockedPeriod {
+		p := &models.TaigaBlockedPeriod{StartDate: *timePtr(start), Reason: reason, BlockedMinutes: minutes}
+		if end != "" {
+			p.EndDate = timePtr(end)
+		}
+		return p
+	}
+	now := *timePtr("2024-01-10T00:00:00Z")
+
+	tests := []struct {
+		name        string
+		changelogs  []models.TaigaIssueChangelog
+		currentNote string
+		want        []*models.TaigaBlockedPeriod
+	}{
+		{
+			name: "never blocked",
+			want: nil,
+		},
+		{
+			name: "blocked and unblocked with the note in the same entry",
+			changelogs: []models.TaigaIssueChangelog{
+				change("h1", "2024-01-01T10:00:00Z", fieldIsBlocked, "true"),
+				change("h1", "2024-01-01T10:00:00Z", fieldBlockedNote, "Waiting for design"),
+				change("h2", "2024-01-01T12:30:00Z", fieldIsBlocked, "false"),
+			},
+			want: []*models.TaigaBlockedPeriod{
+				period("2024-01-01T10:00:00Z", "2024-01-01T12:30:00Z", "Waiting for design", 150),
+			},
+		},
+		{
+			name: "note written after blocking fills the reason",
+			changelogs: []models.TaigaIssueChangelog{
+				change("h1", "2024-01-01T10:00:00Z", fieldIsBlocked, "true"),
+				change("h2", "2024-01-01T11:00:00Z", fieldBlockedNote, "Vendor outage"),
+				change("h3", "2024-01-02T10:00:00Z", fieldIsBlocked, "false"),
+			},
+			want: []*models.TaigaBlockedPeriod{
+				period("2024-01-01T10:00:00Z", "2024-01-02T10:00:00Z", "Vendor outage", 1440),
+			},
+		},
+		{
+			name: "repeated blocks give a period each",
+			changelogs: []models.TaigaIssueChangelog{
+				change("h1", "2024-01-01T10:00:00Z", fieldIsBlocked, "true"),
+				change("h2", "2024-01-01T11:00:00Z", fieldIsBlocked, "false"),
+				change("h3", "2024-01-03T10:00:00Z", fieldIsBlocked, "true"),
+				change("h4", "2024-01-03T10:30:00Z", fieldIsBlocked, "false"),
+			},
+			want: []*models.TaigaBlockedPeriod{
+				period("2024-01-01T10:00:00Z", "2024-01-01T11:00:00Z", "", 60),
+				period("2024-01-03T10:00:00Z", "2024-01-03T10:30:00Z", "", 30),
+			},
+		},
+		{
+			name: "still blocked counts up to now and takes the current note",
+			changelogs: []models.TaigaIssueChangelog{
+				change("h1", "2024-01-09T00:00:00Z", fieldIsBlocked, "true"),
+			},
+			currentNote: "Legal review",
+			want: []*models.TaigaBlockedPeriod{
+				period("2024-01-09T00:00:00Z", "", "Legal review", 1440),
+			},
+		},
+		{
+			name: "unblocking without a block is ignored",
+			changelogs: []models.TaigaIssueChangelog{
+				change("h1", "2024-01-01T10:00:00Z", fieldIsBlocked, "false"),
+			},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := blockedPeriods(tt.changelogs, tt.currentNote, now)
+			assert.Equal(t, len(tt.want), len(got))
+			for i := 0; i < len(tt.want) && i < len(got); i++ {
+				assert.True(t, tt.want[i].StartDate.Equal(got[i].StartDate))
+				assertSameTime(t, tt.want[i].EndDate, got[i].EndDate)
+				assert.Equal(t, tt.want[i].Reason, got[i].Reason)
+				assert.Equal(t, tt.want[i].BlockedMinutes, got[i].BlockedMinutes)
+			}
+		})
+	}
+}
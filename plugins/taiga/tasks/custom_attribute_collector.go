@@ -0,0 +1,117 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/plugin"
+	"github.com/apache/incubator-devlake/helpers/pluginhelper/api"
+	"github.com/irfanuddinahmad/taiga-devlake-plugin/plugins/taiga/models"
+)
+
+const RAW_CUSTOM_ATTRIBUTE_TABLE = "taiga_api_custom_attributes"
+
+var _ plugin.SubTaskEntryPoint = CollectCustomAttributes
+
+var CollectCustomAttributesMeta = plugin.SubTaskMeta{
+	Name:             "collectCustomAttributes",
+	EntryPoint:       skipOnReplay(CollectCustomAttributes),
+	EnabledByDefault: true,
+	Description:      "collect Taiga custom attribute definitions of stories, tasks, issues and epics",
+	DomainTypes:      []string{plugin.DOMAIN_TYPE_TICKET},
+}
+
+// CustomAttributeInput is the input of the custom attribute collector, one request per item type
+type CustomAttributeInput struct {
+	ItemType string
+}
+
+func CollectCustomAttributes(taskCtx plugin.SubTaskContext) errors.Error {
+	data := taskCtx.GetData().(*TaigaTaskData)
+	logger := taskCtx.GetLogger()
+	logger.Info("collect custom attributes")
+
+	collector, err := api.NewApiCollector(api.ApiCollectorArgs{
+		RawDataSubTaskArgs: api.RawDataSubTaskArgs{
+			Ctx: taskCtx,
+			Params: TaigaApiParams{
+				ConnectionId: data.Options.ConnectionId,
+				ProjectId:    data.Options.ProjectId,
+			},
+			Table: RAW_CUSTOM_ATTRIBUTE_TABLE,
+		},
+		ApiClient: data.ApiClient,
+		Input: newSliceIterator(
+			&CustomAttributeInput{ItemType: models.ItemTypeUserStory},
+			&CustomAttributeInput{ItemType: models.ItemTypeTask},
+			&CustomAttributeInput{ItemType: models.ItemTypeIssue},
+			&CustomAttributeInput{ItemType: models.ItemTypeEpic},
+		),
+		UrlTemplate: "{{ .Input.ItemType }}-custom-attributes",
+		Query: func(reqData *api.RequestData) (url.Values, errors.Error) {
+			query := url.Values{}
+			query.Set("project", fmt.Sprintf("%d", data.Options.ProjectId))
+			return query, nil
+		},
+		ResponseParser: func(res *http.Response) ([]json.RawMessage, errors.Error) {
+			var result []json.RawMessage
+			err := api.UnmarshalResponse(res, &result)
+			if err != nil {
+				return nil, err
+			}
+			return result, nil
+		},
+		AfterResponse: withThrottling(data, ignoreHTTPStatus404),
+	})
+	if err != nil {
+		logger.Error(err, "collect custom attributes error")
+		return err
+	}
+	return collector.Execute()
+}
+
+// sliceIterator feeds a fixed list of inputs to a collector
+type sliceIterator struct {
+	items []interface{}
+}
+
+func newSliceIterator(items ...interface{}) *sliceIterator {
+	return &sliceIterator{items: items}
+}
+
+func (it *sliceIterator) HasNext() bool {
+	return len(it.items) > 0
+}
+
+func (it *sliceIterator) Fetch() (interface{}, errors.Error) {
+	if len(it.items) == 0 {
+		return nil, errors.Default.New("no more items")
+	}
+	item := it.items[0]
+	it.items = it.items[1:]
+	return item, nil
+}
+
+func (it *sliceIterator) Close() errors.Error {
+	return nil
+}
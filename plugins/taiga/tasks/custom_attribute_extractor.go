@@ -0,0 +1,89 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"encoding/json"
+
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/plugin"
+	"github.com/apache/incubator-devlake/helpers/pluginhelper/api"
+	"github.com/irfanuddinahmad/taiga-devlake-plugin/plugins/taiga/models"
+)
+
+var _ plugin.SubTaskEntryPoint = ExtractCustomAttributes
+
+var ExtractCustomAttributesMeta = plugin.SubTaskMeta{
+	Name:             "extractCustomAttributes",
+	EntryPoint:       ExtractCustomAttributes,
+	EnabledByDefault: true,
+	Description:      "extract Taiga custom attribute definitions",
+	DomainTypes:      []string{plugin.DOMAIN_TYPE_TICKET},
+	Dependencies:     []*plugin.SubTaskMeta{&CollectCustomAttributesMeta},
+}
+
+func ExtractCustomAttributes(taskCtx plugin.SubTaskContext) errors.Error {
+	data := taskCtx.GetData().(*TaigaTaskData)
+	extractor, err := api.NewApiExtractor(api.ApiExtractorArgs{
+		RawDataSubTaskArgs: api.RawDataSubTaskArgs{
+			Ctx: taskCtx,
+			Params: TaigaApiParams{
+				ConnectionId: data.Options.ConnectionId,
+				ProjectId:    data.Options.ProjectId,
+			},
+			Table: RAW_CUSTOM_ATTRIBUTE_TABLE,
+		},
+		Extract: func(row *api.RawData) ([]interface{}, errors.Error) {
+			var input CustomAttributeInput
+			err := json.Unmarshal(row.Input, &input)
+			if err != nil {
+				return nil, errors.Default.Wrap(err, "error unmarshalling custom attribute input")
+			}
+			var apiAttribute struct {
+				Id          uint64 `json:"id"`
+				Name        string `json:"name"`
+				Description string `json:"description"`
+				Type        string `json:"type"`
+				Order       int    `json:"order"`
+			}
+			err = json.Unmarshal(row.Data, &apiAttribute)
+			if err != nil {
+				return nil, errors.Default.Wrap(err, "error unmarshalling custom attribute")
+			}
+
+			attribute := &models.TaigaCustomAttribute{
+				ConnectionId: data.Options.ConnectionId,
+				AttributeId:  apiAttribute.Id,
+				ProjectId:    data.Options.ProjectId,
+				ItemType:     input.ItemType,
+				Name:         apiAttribute.Name,
+				Description:  apiAttribute.Description,
+				Type:         apiAttribute.Type,
+				Order:        apiAttribute.Order,
+			}
+
+			return []interface{}{attribute}, nil
+		},
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return extractor.Execute()
+}
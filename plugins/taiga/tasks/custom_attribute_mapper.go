@@ -0,0 +1,128 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/apache/incubator-devlake/core/dal"
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/models/domainlayer"
+	"github.com/apache/incubator-devlake/core/models/domainlayer/ticket"
+	"github.com/irfanuddinahmad/taiga-devlake-plugin/plugins/taiga/models"
+)
+
+// loadCustomAttributeValues returns the custom attribute values of the project's items of one type,
+// keyed by item id and then attribute name, or nil when the scope config maps no attribute
+func loadCustomAttributeValues(db dal.Dal, data *TaigaTaskData, itemType string) (map[uint64]map[string]string, errors.Error) {
+	if len(data.Options.ScopeConfig.CustomAttributeMappings) == 0 {
+		return nil, nil
+	}
+	var rows []struct {
+		ItemId uint64
+		Name   string
+		Value  string
+	}
+	err := db.All(
+		&rows,
+		dal.Select("v.item_id, a.name, v.value"),
+		dal.From("_tool_taiga_custom_attribute_values v"),
+		// attribute ids are only unique within an item type
+		dal.Join("LEFT JOIN _tool_taiga_custom_attributes a ON a.connection_id = v.connection_id AND a.item_type = v.item_type AND a.attribute_id = v.attribute_id"),
+		dal.Where("v.connection_id = ? AND v.project_id = ? AND v.item_type = ?",
+			data.Options.ConnectionId, data.Options.ProjectId, itemType),
+	)
+	if err != nil {
+		return nil, err
+	}
+	values := make(map[uint64]map[string]string)
+	for _, row := range rows {
+		if values[row.ItemId] == nil {
+			values[row.ItemId] = make(map[string]string)
+		}
+		values[row.ItemId][row.Name] = row.Value
+	}
+	return values, nil
+}
+
+// applyCustomAttributes copies the mapped attribute values onto the issue, values that cannot be
+// parsed are left out
+func applyCustomAttributes(issue *ticket.Issue, values map[string]string, mappings map[string]models.CustomAttributeMapping) {
+	for field, mapping := range mappings {
+		value, ok := values[mapping.Attribute]
+		if !ok || value == "" {
+			continue
+		}
+		switch field {
+		case models.IssueFieldComponent:
+			issue.Component = value
+		case models.IssueFieldSeverity:
+			issue.Severity = value
+		case models.IssueFieldPriority:
+			issue.Priority = value
+		case models.IssueFieldOriginalEstimateMinutes:
+			issue.OriginalEstimateMinutes = parseMinutes(value, mapping)
+		case models.IssueFieldTimeRemainingMinutes:
+			issue.TimeRemainingMinutes = parseMinutes(value, mapping)
+		case models.IssueFieldTimeSpentMinutes:
+			issue.TimeSpentMinutes = parseMinutes(value, mapping)
+		}
+	}
+}
+
+// parseMinutes converts a numeric attribute value in the unit of its mapping to whole minutes, a day
+// lasts the hours per day of the mapping
+func parseMinutes(value string, mapping models.CustomAttributeMapping) *int64 {
+	number, err := strconv.ParseFloat(strings.TrimSpace(strings.ReplaceAll(value, ",", ".")), 64)
+	if err != nil {
+		return nil
+	}
+	switch mapping.Unit {
+	case models.TimeUnitHours:
+		number *= 60
+	case models.TimeUnitDays:
+		hoursPerDay := mapping.HoursPerDay
+		if hoursPerDay == 0 {
+			hoursPerDay = models.DefaultHoursPerDay
+		}
+		number *= 60 * hoursPerDay
+	}
+	minutes := int64(math.Round(number))
+	return &minutes
+}
+
+// spentTimeWorklog turns the time spent recorded on an issue into a single worklog, Taiga keeps no
+// individual time entries so the worklog is attributed to the assignee at the last modification.
+// The worklog is named after the issue and the field it comes from, other sources of worklogs of
+// the same issue get ids of their own
+func spentTimeWorklog(issue *ticket.Issue, assigneeId string, loggedDate *time.Time, mappings map[string]models.CustomAttributeMapping) *ticket.IssueWorklog {
+	if issue.TimeSpentMinutes == nil || *issue.TimeSpentMinutes <= 0 {
+		return nil
+	}
+	return &ticket.IssueWorklog{
+		DomainEntity:     domainlayer.DomainEntity{Id: issue.Id + ":" + models.IssueFieldTimeSpentMinutes},
+		IssueId:          issue.Id,
+		AuthorId:         assigneeId,
+		Comment:          mappings[models.IssueFieldTimeSpentMinutes].Attribute,
+		TimeSpentMinutes: int(*issue.TimeSpentMinutes),
+		LoggedDate:       loggedDate,
+	}
+}
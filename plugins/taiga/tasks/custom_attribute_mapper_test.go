@@ -0,0 +1,109 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"testing"
+	"time"
+
+	"github.com/apache/incubator-devlake/core/models/domainlayer/ticket"
+	"github.com/irfanuddinahmad/taiga-devlake-plugin/plugins/taiga/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func int64Ptr(value int64) *int64 {
+	return &value
+}
+
+func TestParseMinutes(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		mapping models.CustomAttributeMapping
+		want    *int64
+	}{
+		{"minutes by default", "90", models.CustomAttributeMapping{}, int64Ptr(90)},
+		{"minutes", "45", models.CustomAttributeMapping{Unit: models.TimeUnitMinutes}, int64Ptr(45)},
+		{"hours", "1.5", models.CustomAttributeMapping{Unit: models.TimeUnitHours}, int64Ptr(90)},
+		{"decimal comma", "1,5", models.CustomAttributeMapping{Unit: models.TimeUnitHours}, int64Ptr(90)},
+		{"days are working days", "2", models.CustomAttributeMapping{Unit: models.TimeUnitDays}, int64Ptr(960)},
+		{"days of the mapping", "2", models.CustomAttributeMapping{Unit: models.TimeUnitDays, HoursPerDay: 7.5}, int64Ptr(900)},
+		{"rounded", "0.01", models.CustomAttributeMapping{Unit: models.TimeUnitHours}, int64Ptr(1)},
+		{"surrounding spaces", " 30 ", models.CustomAttributeMapping{}, int64Ptr(30)},
+		{"not a number", "two hours", models.CustomAttributeMapping{Unit: models.TimeUnitHours}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, parseMinutes(tt.value, tt.mapping))
+		})
+	}
+}
+
+func TestApplyCustomAttributes(t *testing.T) {
+	mappings := map[string]models.CustomAttributeMapping{
+		models.IssueFieldComponent:               {Attribute: "Component"},
+		models.IssueFieldSeverity:                {Attribute: "Severity"},
+		models.IssueFieldPriority:                {Attribute: "Priority"},
+		models.IssueFieldOriginalEstimateMinutes: {Attribute: "Estimate", Unit: models.TimeUnitHours},
+		models.IssueFieldTimeRemainingMinutes:    {Attribute: "Remaining", Unit: models.TimeUnitDays},
+		models.IssueFieldTimeSpentMinutes:        {Attribute: "Spent"},
+	}
+	tests := []struct {
+		name   string
+		values map[string]string
+		want   ticket.Issue
+	}{
+		{"no values", nil, ticket.Issue{}},
+		{"text fields", map[string]string{"Component": "api", "Severity": "major", "Priority": "high"},
+			ticket.Issue{Component: "api", Severity: "major", Priority: "high"}},
+		{"time fields in their units", map[string]string{"Estimate": "2", "Remaining": "0.5", "Spent": "75"},
+			ticket.Issue{OriginalEstimateMinutes: int64Ptr(120), TimeRemainingMinutes: int64Ptr(240), TimeSpentMinutes: int64Ptr(75)}},
+		{"empty and unparsable values are left out", map[string]string{"Component": "", "Estimate": "soon"},
+			ticket.Issue{}},
+		{"unmapped attributes are ignored", map[string]string{"Team": "core"}, ticket.Issue{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			issue := ticket.Issue{}
+			applyCustomAttributes(&issue, tt.values, mappings)
+			assert.Equal(t, tt.want, issue)
+		})
+	}
+}
+
+func TestSpentTimeWorklog(t *testing.T) {
+	loggedDate := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	mappings := map[string]models.CustomAttributeMapping{
+		models.IssueFieldTimeSpentMinutes: {Attribute: "Spent"},
+	}
+
+	issue := &ticket.Issue{}
+	issue.Id = "taiga:TaigaUserStory:1:101"
+	assert.Nil(t, spentTimeWorklog(issue, "taiga:TaigaAccount:1:7", &loggedDate, mappings))
+	issue.TimeSpentMinutes = int64Ptr(0)
+	assert.Nil(t, spentTimeWorklog(issue, "taiga:TaigaAccount:1:7", &loggedDate, mappings))
+
+	issue.TimeSpentMinutes = int64Ptr(75)
+	worklog := spentTimeWorklog(issue, "taiga:TaigaAccount:1:7", &loggedDate, mappings)
+	assert.Equal(t, "taiga:TaigaUserStory:1:101:timeSpentMinutes", worklog.Id)
+	assert.Equal(t, issue.Id, worklog.IssueId)
+	assert.Equal(t, "taiga:TaigaAccount:1:7", worklog.AuthorId)
+	assert.Equal(t, "Spent", worklog.Comment)
+	assert.Equal(t, 75, worklog.TimeSpentMinutes)
+	assert.Equal(t, &loggedDate, worklog.LoggedDate)
+}
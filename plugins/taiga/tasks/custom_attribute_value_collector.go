@@ -0,0 +1,137 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+
+	"github.com/apache/incubator-devlake/core/dal"
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/plugin"
+	"github.com/apache/incubator-devlake/helpers/pluginhelper/api"
+	"github.com/irfanuddinahmad/taiga-devlake-plugin/plugins/taiga/models"
+)
+
+const (
+	RAW_CUSTOM_ATTRIBUTE_VALUE_TABLE      = "taiga_api_custom_attribute_values"
+	RAW_TASK_CUSTOM_ATTRIBUTE_VALUE_TABLE = "taiga_api_task_custom_attribute_values"
+)
+
+var _ plugin.SubTaskEntryPoint = CollectCustomAttributeValues
+
+var CollectCustomAttributeValuesMeta = plugin.SubTaskMeta{
+	Name:             "collectCustomAttributeValues",
+	EntryPoint:       skipOnReplay(CollectCustomAttributeValues),
+	EnabledByDefault: true,
+	Description:      "collect Taiga custom attribute values of user stories",
+	DomainTypes:      []string{plugin.DOMAIN_TYPE_TICKET},
+	Dependencies:     []*plugin.SubTaskMeta{&ExtractUserStoriesMeta, &ExtractCustomAttributesMeta},
+}
+
+var CollectTaskCustomAttributeValuesMeta = plugin.SubTaskMeta{
+	Name:             "collectTaskCustomAttributeValues",
+	EntryPoint:       skipOnReplay(CollectTaskCustomAttributeValues),
+	EnabledByDefault: true,
+	Description:      "collect Taiga custom attribute values of tasks",
+	DomainTypes:      []string{plugin.DOMAIN_TYPE_TICKET},
+	Dependencies:     []*plugin.SubTaskMeta{&ExtractTasksMeta, &ExtractCustomAttributesMeta},
+}
+
+// SimpleUserStory is the input of the collectors that request one endpoint per user story
+type SimpleUserStory struct {
+	UserStoryId uint64
+}
+
+func CollectCustomAttributeValues(taskCtx plugin.SubTaskContext) errors.Error {
+	return collectCustomAttributeValues(taskCtx, models.ItemTypeUserStory, RAW_CUSTOM_ATTRIBUTE_VALUE_TABLE,
+		"userstories/custom-attributes-values/{{ .Input.UserStoryId }}",
+		dal.Select("user_story_id"), dal.From(&models.TaigaUserStory{}), reflect.TypeOf(SimpleUserStory{}))
+}
+
+func CollectTaskCustomAttributeValues(taskCtx plugin.SubTaskContext) errors.Error {
+	return collectCustomAttributeValues(taskCtx, models.ItemTypeTask, RAW_TASK_CUSTOM_ATTRIBUTE_VALUE_TABLE,
+		"tasks/custom-attributes-values/{{ .Input.TaskId }}",
+		dal.Select("task_id"), dal.From(&models.TaigaTask{}), reflect.TypeOf(SimpleTask{}))
+}
+
+// collectCustomAttributeValues requests the values of every item of one type, the items are selected
+// from their tool table and fed to urlTemplate as inputType
+func collectCustomAttributeValues(taskCtx plugin.SubTaskContext, itemType string, table string, urlTemplate string,
+	selectId dal.Clause, from dal.Clause, inputType reflect.Type) errors.Error {
+	data := taskCtx.GetData().(*TaigaTaskData)
+	logger := taskCtx.GetLogger()
+	db := taskCtx.GetDal()
+	logger.Info("collect %s custom attribute values", itemType)
+
+	// one request per item is expensive, don't bother when the project has no attributes
+	count, err := db.Count(
+		dal.From(&models.TaigaCustomAttribute{}),
+		dal.Where("connection_id = ? AND project_id = ? AND item_type = ?",
+			data.Options.ConnectionId, data.Options.ProjectId, itemType),
+	)
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		logger.Info("project %d has no %s custom attributes, skipping", data.Options.ProjectId, itemType)
+		return nil
+	}
+
+	cursor, err := db.Cursor(
+		selectId,
+		from,
+		dal.Where("connection_id = ? AND project_id = ?", data.Options.ConnectionId, data.Options.ProjectId),
+	)
+	if err != nil {
+		return err
+	}
+	iterator, err := api.NewDalCursorIterator(db, cursor, inputType)
+	if err != nil {
+		return err
+	}
+
+	collector, err := api.NewApiCollector(api.ApiCollectorArgs{
+		RawDataSubTaskArgs: api.RawDataSubTaskArgs{
+			Ctx: taskCtx,
+			Params: TaigaApiParams{
+				ConnectionId: data.Options.ConnectionId,
+				ProjectId:    data.Options.ProjectId,
+			},
+			Table: table,
+		},
+		ApiClient:   data.ApiClient,
+		Input:       iterator,
+		UrlTemplate: urlTemplate,
+		ResponseParser: func(res *http.Response) ([]json.RawMessage, errors.Error) {
+			var result json.RawMessage
+			err := api.UnmarshalResponse(res, &result)
+			if err != nil {
+				return nil, err
+			}
+			return []json.RawMessage{result}, nil
+		},
+		AfterResponse: withThrottling(data, ignoreHTTPStatus404),
+	})
+	if err != nil {
+		logger.Error(err, "collect custom attribute values error")
+		return err
+	}
+	return collector.Execute()
+}
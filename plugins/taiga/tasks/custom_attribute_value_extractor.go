@@ -0,0 +1,204 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/apache/incubator-devlake/core/dal"
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/plugin"
+	"github.com/apache/incubator-devlake/helpers/pluginhelper/api"
+	"github.com/irfanuddinahmad/taiga-devlake-plugin/plugins/taiga/models"
+)
+
+var _ plugin.SubTaskEntryPoint = ExtractCustomAttributeValues
+
+var ExtractCustomAttributeValuesMeta = plugin.SubTaskMeta{
+	Name:             "extractCustomAttributeValues",
+	EntryPoint:       ExtractCustomAttributeValues,
+	EnabledByDefault: true,
+	Description:      "extract Taiga custom attribute values of user stories",
+	DomainTypes:      []string{plugin.DOMAIN_TYPE_TICKET},
+	Dependencies:     []*plugin.SubTaskMeta{&CollectCustomAttributeValuesMeta, &ExtractTasksMeta},
+}
+
+var ExtractTaskCustomAttributeValuesMeta = plugin.SubTaskMeta{
+	Name:             "extractTaskCustomAttributeValues",
+	EntryPoint:       ExtractTaskCustomAttributeValues,
+	EnabledByDefault: true,
+	Description:      "extract Taiga custom attribute values of tasks",
+	DomainTypes:      []string{plugin.DOMAIN_TYPE_TICKET},
+	Dependencies:     []*plugin.SubTaskMeta{&CollectTaskCustomAttributeValuesMeta, &ExtractUserStoriesMeta},
+}
+
+func ExtractCustomAttributeValues(taskCtx plugin.SubTaskContext) errors.Error {
+	return extractCustomAttributeValues(taskCtx, models.ItemTypeUserStory, RAW_CUSTOM_ATTRIBUTE_VALUE_TABLE)
+}
+
+func ExtractTaskCustomAttributeValues(taskCtx plugin.SubTaskContext) errors.Error {
+	return extractCustomAttributeValues(taskCtx, models.ItemTypeTask, RAW_TASK_CUSTOM_ATTRIBUTE_VALUE_TABLE)
+}
+
+func extractCustomAttributeValues(taskCtx plugin.SubTaskContext, itemType string, table string) errors.Error {
+	data := taskCtx.GetData().(*TaigaTaskData)
+	duplicateOfId, itemsByRef, err := loadDuplicateOf(taskCtx.GetDal(), data, itemType)
+	if err != nil {
+		return err
+	}
+	extractor, err := api.NewApiExtractor(api.ApiExtractorArgs{
+		RawDataSubTaskArgs: api.RawDataSubTaskArgs{
+			Ctx: taskCtx,
+			Params: TaigaApiParams{
+				ConnectionId: data.Options.ConnectionId,
+				ProjectId:    data.Options.ProjectId,
+			},
+			Table: table,
+		},
+		Extract: func(row *api.RawData) ([]interface{}, errors.Error) {
+			// the item is named after its type, e.g. user_story or task
+			var apiValues struct {
+				AttributesValues map[string]json.RawMessage `json:"attributes_values"`
+				UserStory        uint64                     `json:"user_story"`
+				Task             uint64                     `json:"task"`
+			}
+			err := json.Unmarshal(row.Data, &apiValues)
+			if err != nil {
+				return nil, errors.Default.Wrap(err, "error unmarshalling custom attribute values")
+			}
+			itemId := apiValues.UserStory
+			if itemType == models.ItemTypeTask {
+				itemId = apiValues.Task
+			}
+
+			var results []interface{}
+			for key, raw := range apiValues.AttributesValues {
+				attributeId, convErr := strconv.ParseUint(key, 10, 64)
+				if convErr != nil {
+					return nil, errors.Default.Wrap(convErr, "invalid custom attribute id")
+				}
+				value := rawValueToString(raw)
+				if value == "" {
+					continue
+				}
+				results = append(results, &models.TaigaCustomAttributeValue{
+					ConnectionId: data.Options.ConnectionId,
+					ItemType:     itemType,
+					ItemId:       itemId,
+					AttributeId:  attributeId,
+					ProjectId:    data.Options.ProjectId,
+					Value:        value,
+				})
+				if duplicateOfId == 0 || attributeId != duplicateOfId {
+					continue
+				}
+				ref, ok := parseRef(value)
+				if !ok {
+					continue
+				}
+				original, ok := itemsByRef[ref]
+				if !ok || (original.itemType == itemType && original.itemId == itemId) {
+					continue
+				}
+				results = append(results, &models.TaigaItemRelationship{
+					ConnectionId:     data.Options.ConnectionId,
+					SourceItemType:   itemType,
+					SourceItemId:     itemId,
+					TargetItemType:   original.itemType,
+					TargetItemId:     original.itemId,
+					RelationshipType: models.RelationshipDuplicate,
+					ProjectId:        data.Options.ProjectId,
+				})
+			}
+
+			return results, nil
+		},
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return extractor.Execute()
+}
+
+// projectItem is a user story or task of the project
+type projectItem struct {
+	itemType string
+	itemId   uint64
+}
+
+// loadDuplicateOf returns the id of the custom attribute mapped onto duplicateOf for the item type,
+// along with the project's user stories and tasks keyed by ref, the id is zero when none is mapped
+func loadDuplicateOf(db dal.Dal, data *TaigaTaskData, itemType string) (uint64, map[int]projectItem, errors.Error) {
+	mapping, ok := data.Options.ScopeConfig.CustomAttributeMappings[models.IssueFieldDuplicateOf]
+	if !ok {
+		return 0, nil, nil
+	}
+	var attribute models.TaigaCustomAttribute
+	err := db.First(&attribute, dal.Where("connection_id = ? AND project_id = ? AND item_type = ? AND name = ?",
+		data.Options.ConnectionId, data.Options.ProjectId, itemType, mapping.Attribute))
+	if err != nil {
+		if db.IsErrorNotFound(err) {
+			return 0, nil, nil
+		}
+		return 0, nil, err
+	}
+
+	// refs are numbered per project across user stories, tasks and issues
+	itemsByRef := make(map[int]projectItem)
+	var userStories []models.TaigaUserStory
+	err = db.All(&userStories, dal.Where("connection_id = ? AND project_id = ?", data.Options.ConnectionId, data.Options.ProjectId))
+	if err != nil {
+		return 0, nil, err
+	}
+	for _, userStory := range userStories {
+		itemsByRef[userStory.Ref] = projectItem{models.ItemTypeUserStory, userStory.UserStoryId}
+	}
+	var tasks []models.TaigaTask
+	err = db.All(&tasks, dal.Where("connection_id = ? AND project_id = ?", data.Options.ConnectionId, data.Options.ProjectId))
+	if err != nil {
+		return 0, nil, err
+	}
+	for _, task := range tasks {
+		itemsByRef[task.Ref] = projectItem{models.ItemTypeTask, task.TaskId}
+	}
+	return attribute.AttributeId, itemsByRef, nil
+}
+
+// parseRef reads an item ref as typed in Taiga, with or without the leading #
+func parseRef(value string) (int, bool) {
+	ref, err := strconv.Atoi(strings.TrimPrefix(strings.TrimSpace(value), "#"))
+	return ref, err == nil && ref > 0
+}
+
+// rawValueToString flattens a JSON value, e.g. of a custom attribute, strings are unquoted and
+// numbers, booleans or dates are kept as written by Taiga
+func rawValueToString(raw json.RawMessage) string {
+	var text string
+	if json.Unmarshal(raw, &text) == nil {
+		return strings.TrimSpace(text)
+	}
+	value := strings.TrimSpace(string(raw))
+	if value == "null" {
+		return ""
+	}
+	return value
+}
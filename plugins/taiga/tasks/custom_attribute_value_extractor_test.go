@@ -0,0 +1,72 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRawValueToString(t *testing.T) {
+	tests := []struct {
+		name string
+		raw  string
+		want string
+	}{
+		{"string is unquoted", `"Backend"`, "Backend"},
+		{"string is trimmed", `"  Backend "`, "Backend"},
+		{"empty string", `""`, ""},
+		{"null", `null`, ""},
+		{"integer", `42`, "42"},
+		{"decimal", `1.5`, "1.5"},
+		{"boolean", `true`, "true"},
+		{"array is kept as written", `["a", "b"]`, `["a", "b"]`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, rawValueToString(json.RawMessage(tt.raw)))
+		})
+	}
+}
+
+func TestParseRef(t *testing.T) {
+	tests := []struct {
+		name   string
+		value  string
+		want   int
+		wantOk bool
+	}{
+		{"with hash", "#12", 12, true},
+		{"without hash", "12", 12, true},
+		{"padded", " #7 ", 7, true},
+		{"zero", "#0", 0, false},
+		{"text", "see #12", 0, false},
+		{"empty", "", 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ref, ok := parseRef(tt.value)
+			assert.Equal(t, tt.wantOk, ok)
+			if tt.wantOk {
+				assert.Equal(t, tt.want, ref)
+			}
+		})
+	}
+}
@@ -0,0 +1,110 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"reflect"
+
+	"github.com/apache/incubator-devlake/core/dal"
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/models/domainlayer"
+	"github.com/apache/incubator-devlake/core/models/domainlayer/didgen"
+	"github.com/apache/incubator-devlake/core/plugin"
+	"github.com/apache/incubator-devlake/helpers/pluginhelper/api"
+	"github.com/irfanuddinahmad/taiga-devlake-plugin/plugins/taiga/models"
+)
+
+var ConvertDocumentationActivitiesMeta = plugin.SubTaskMeta{
+	Name:             "convertDocumentationActivities",
+	EntryPoint:       ConvertDocumentationActivities,
+	EnabledByDefault: true,
+	Description:      "convert Taiga wiki page edits into documentation activities",
+	DomainTypes:      []string{models.ENTITY_TYPE_WIKI},
+	Dependencies:     []*plugin.SubTaskMeta{&ExtractWikiPagesMeta, &ExtractWikiLinksMeta, &ExtractWikiHistoriesMeta},
+}
+
+// wikiPageEdit is a wiki page edit joined with the slug of its page and the title of the wiki link
+// to it, Taiga pages have no title of their own
+type wikiPageEdit struct {
+	models.TaigaWikiPageEdit
+	Slug  string
+	Title string
+}
+
+func ConvertDocumentationActivities(taskCtx plugin.SubTaskContext) errors.Error {
+	data := taskCtx.GetData().(*TaigaTaskData)
+	logger := taskCtx.GetLogger()
+	db := taskCtx.GetDal()
+	logger.Info("convert documentation activities of project:%d", data.Options.ProjectId)
+
+	activityIdGen := didgen.NewDomainIdGenerator(&models.TaigaWikiPageEdit{})
+	pageIdGen := didgen.NewDomainIdGenerator(&models.TaigaWikiPage{})
+	boardIdGen := didgen.NewDomainIdGenerator(&models.TaigaProject{})
+	accountIdGen := didgen.NewDomainIdGenerator(&models.TaigaAccount{})
+	clauses := []dal.Clause{
+		dal.Select("e.*, p.slug, (SELECT MIN(l.title) FROM _tool_taiga_wiki_links l " +
+			"WHERE l.connection_id = p.connection_id AND l.project_id = p.project_id AND l.href = p.slug) AS title"),
+		dal.From("_tool_taiga_wiki_page_edits e"),
+		dal.Join("LEFT JOIN _tool_taiga_wiki_pages p ON p.connection_id = e.connection_id AND p.wiki_page_id = e.wiki_page_id"),
+		dal.Where("e.connection_id = ? AND e.project_id = ?", data.Options.ConnectionId, data.Options.ProjectId),
+	}
+	clauses = append(clauses, timeAfterClauses(data, "e.created_date")...)
+	cursor, err := db.Cursor(clauses...)
+	if err != nil {
+		return err
+	}
+	defer cursor.Close()
+
+	converter, err := api.NewDataConverter(api.DataConverterArgs{
+		RawDataSubTaskArgs: api.RawDataSubTaskArgs{
+			Ctx: taskCtx,
+			Params: TaigaApiParams{
+				ConnectionId: data.Options.ConnectionId,
+				ProjectId:    data.Options.ProjectId,
+			},
+			Table: RAW_WIKI_HISTORY_TABLE,
+		},
+		InputRowType: reflect.TypeOf(wikiPageEdit{}),
+		Input:        cursor,
+		Convert: func(inputRow interface{}) ([]interface{}, errors.Error) {
+			edit := inputRow.(*wikiPageEdit)
+			title := edit.Title
+			if title == "" {
+				title = edit.Slug
+			}
+			activity := &models.TaigaDocumentationActivity{
+				DomainEntity: domainlayer.DomainEntity{Id: activityIdGen.Generate(edit.ConnectionId, edit.EditId)},
+				BoardId:      boardIdGen.Generate(edit.ConnectionId, edit.ProjectId),
+				PageId:       pageIdGen.Generate(edit.ConnectionId, edit.WikiPageId),
+				PageTitle:    title,
+				AuthorId:     accountIdGen.Generate(edit.ConnectionId, edit.AuthorId),
+				AuthorName:   edit.AuthorName,
+				ActivityType: edit.EditType,
+			}
+			if edit.CreatedDate != nil {
+				activity.CreatedDate = *edit.CreatedDate
+			}
+			return []interface{}{activity}, nil
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	return converter.Execute()
+}
@@ -0,0 +1,111 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"reflect"
+
+	"github.com/apache/incubator-devlake/core/dal"
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/models/domainlayer/didgen"
+	"github.com/apache/incubator-devlake/core/models/domainlayer/ticket"
+	"github.com/apache/incubator-devlake/core/plugin"
+	"github.com/apache/incubator-devlake/helpers/pluginhelper/api"
+	"github.com/irfanuddinahmad/taiga-devlake-plugin/plugins/taiga/models"
+)
+
+// Field ids of the issue custom array fields watchers and voters are exposed as, the values are account ids
+const (
+	FieldIdWatchers = "taiga_watchers"
+	FieldIdVoters   = "taiga_voters"
+)
+
+var ConvertEngagementsMeta = plugin.SubTaskMeta{
+	Name:             "convertEngagements",
+	EntryPoint:       ConvertEngagements,
+	EnabledByDefault: true,
+	Description:      "convert watchers of Taiga user stories and tasks and voters of user stories into issue custom array fields",
+	DomainTypes:      []string{plugin.DOMAIN_TYPE_TICKET},
+	Dependencies:     []*plugin.SubTaskMeta{&ExtractUserStoriesMeta, &ExtractTasksMeta, &ExtractUserStoryVotersMeta},
+}
+
+func ConvertEngagements(taskCtx plugin.SubTaskContext) errors.Error {
+	data := taskCtx.GetData().(*TaigaTaskData)
+	logger := taskCtx.GetLogger()
+	logger.Info("convert watchers and voters of project:%d", data.Options.ProjectId)
+
+	accountIdGen := didgen.NewDomainIdGenerator(&models.TaigaAccount{})
+	for _, source := range issueSources() {
+		issueIdGen := source.issueIdGen
+		err := convertEngagement(taskCtx, &models.TaigaItemWatcher{}, source.itemType, source.rawTable, func(inputRow interface{}) ([]interface{}, errors.Error) {
+			watcher := inputRow.(*models.TaigaItemWatcher)
+			return []interface{}{&ticket.IssueCustomArrayField{
+				IssueID:    issueIdGen.Generate(watcher.ConnectionId, watcher.ItemId),
+				FieldID:    FieldIdWatchers,
+				FieldValue: accountIdGen.Generate(watcher.ConnectionId, watcher.AccountId),
+			}}, nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	// voters are only collected for user stories
+	storyIdGen := didgen.NewDomainIdGenerator(&models.TaigaUserStory{})
+	return convertEngagement(taskCtx, &models.TaigaItemVoter{}, models.ItemTypeUserStory, RAW_USER_STORY_VOTER_TABLE, func(inputRow interface{}) ([]interface{}, errors.Error) {
+		voter := inputRow.(*models.TaigaItemVoter)
+		return []interface{}{&ticket.IssueCustomArrayField{
+			IssueID:    storyIdGen.Generate(voter.ConnectionId, voter.ItemId),
+			FieldID:    FieldIdVoters,
+			FieldValue: accountIdGen.Generate(voter.ConnectionId, voter.AccountId),
+		}}, nil
+	})
+}
+
+// convertEngagement converts the rows of one item type in one engagement table, watchers or voters
+func convertEngagement(taskCtx plugin.SubTaskContext, model dal.Tabler, itemType string, rawTable string, convert api.DataConvertHandler) errors.Error {
+	data := taskCtx.GetData().(*TaigaTaskData)
+	db := taskCtx.GetDal()
+	cursor, err := db.Cursor(
+		dal.From(model),
+		dal.Where("connection_id = ? AND project_id = ? AND item_type = ?",
+			data.Options.ConnectionId, data.Options.ProjectId, itemType),
+	)
+	if err != nil {
+		return err
+	}
+	defer cursor.Close()
+
+	converter, err := api.NewDataConverter(api.DataConverterArgs{
+		RawDataSubTaskArgs: api.RawDataSubTaskArgs{
+			Ctx: taskCtx,
+			Params: TaigaApiParams{
+				ConnectionId: data.Options.ConnectionId,
+				ProjectId:    data.Options.ProjectId,
+			},
+			Table: rawTable,
+		},
+		InputRowType: reflect.TypeOf(model).Elem(),
+		Input:        cursor,
+		Convert:      convert,
+	})
+	if err != nil {
+		return err
+	}
+
+	return converter.Execute()
+}
@@ -0,0 +1,109 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"reflect"
+
+	"github.com/apache/incubator-devlake/core/dal"
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/models/domainlayer"
+	"github.com/apache/incubator-devlake/core/models/domainlayer/didgen"
+	"github.com/apache/incubator-devlake/core/models/domainlayer/ticket"
+	"github.com/apache/incubator-devlake/core/plugin"
+	"github.com/apache/incubator-devlake/helpers/pluginhelper/api"
+	"github.com/irfanuddinahmad/taiga-devlake-plugin/plugins/taiga/models"
+)
+
+var ConvertIssueChangelogsMeta = plugin.SubTaskMeta{
+	Name:             "convertIssueChangelogs",
+	EntryPoint:       ConvertIssueChangelogs,
+	EnabledByDefault: true,
+	Description:      "convert Taiga history changes of user stories and tasks into issue changelogs",
+	DomainTypes:      []string{models.ENTITY_TYPE_HISTORY},
+	Dependencies:     []*plugin.SubTaskMeta{&ExtractUserStoryHistoriesMeta, &ExtractTaskHistoriesMeta},
+}
+
+func ConvertIssueChangelogs(taskCtx plugin.SubTaskContext) errors.Error {
+	data := taskCtx.GetData().(*TaigaTaskData)
+	logger := taskCtx.GetLogger()
+	logger.Info("convert issue changelogs of project:%d", data.Options.ProjectId)
+
+	for _, source := range issueSources() {
+		if err := convertIssueChangelogs(taskCtx, source); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func convertIssueChangelogs(taskCtx plugin.SubTaskContext, source issueSource) errors.Error {
+	data := taskCtx.GetData().(*TaigaTaskData)
+	db := taskCtx.GetDal()
+	changelogIdGen := didgen.NewDomainIdGenerator(&models.TaigaIssueChangelog{})
+	accountIdGen := didgen.NewDomainIdGenerator(&models.TaigaAccount{})
+	clauses := []dal.Clause{
+		dal.Select("*"),
+		dal.From(&models.TaigaIssueChangelog{}),
+		dal.Where("connection_id = ? AND project_id = ? AND item_type = ?",
+			data.Options.ConnectionId, data.Options.ProjectId, source.itemType),
+	}
+	clauses = append(clauses, timeAfterClauses(data, "created_date")...)
+	cursor, err := db.Cursor(clauses...)
+	if err != nil {
+		return err
+	}
+	defer cursor.Close()
+
+	converter, err := api.NewDataConverter(api.DataConverterArgs{
+		RawDataSubTaskArgs: api.RawDataSubTaskArgs{
+			Ctx: taskCtx,
+			Params: TaigaApiParams{
+				ConnectionId: data.Options.ConnectionId,
+				ProjectId:    data.Options.ProjectId,
+			},
+			Table: source.historyRawTable,
+		},
+		InputRowType: reflect.TypeOf(models.TaigaIssueChangelog{}),
+		Input:        cursor,
+		Convert: func(inputRow interface{}) ([]interface{}, errors.Error) {
+			changelog := inputRow.(*models.TaigaIssueChangelog)
+			issueChangelog := &ticket.IssueChangelogs{
+				DomainEntity:      domainlayer.DomainEntity{Id: changelogIdGen.Generate(changelog.ConnectionId, changelog.ChangelogId, changelog.FieldName)},
+				IssueId:           source.issueIdGen.Generate(changelog.ConnectionId, changelog.ItemId),
+				AuthorId:          accountIdGen.Generate(changelog.ConnectionId, changelog.AuthorId),
+				AuthorName:        changelog.AuthorName,
+				FieldId:           changelog.FieldName,
+				FieldName:         changelog.FieldName,
+				OriginalFromValue: changelog.FromValue,
+				OriginalToValue:   changelog.ToValue,
+				FromValue:         changelog.FromValue,
+				ToValue:           changelog.ToValue,
+			}
+			if changelog.CreatedDate != nil {
+				issueChangelog.CreatedDate = *changelog.CreatedDate
+			}
+			return []interface{}{issueChangelog}, nil
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	return converter.Execute()
+}
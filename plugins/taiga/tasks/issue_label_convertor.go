@@ -0,0 +1,97 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/apache/incubator-devlake/core/dal"
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/models/domainlayer/ticket"
+	"github.com/apache/incubator-devlake/core/plugin"
+	"github.com/apache/incubator-devlake/helpers/pluginhelper/api"
+	"github.com/irfanuddinahmad/taiga-devlake-plugin/plugins/taiga/models"
+)
+
+var ConvertIssueLabelsMeta = plugin.SubTaskMeta{
+	Name:             "convertIssueLabels",
+	EntryPoint:       ConvertIssueLabels,
+	EnabledByDefault: true,
+	Description:      "convert Taiga tags of user stories and tasks into issue labels",
+	DomainTypes:      []string{plugin.DOMAIN_TYPE_TICKET},
+	Dependencies:     []*plugin.SubTaskMeta{&ExtractUserStoriesMeta, &ExtractTasksMeta},
+}
+
+func ConvertIssueLabels(taskCtx plugin.SubTaskContext) errors.Error {
+	data := taskCtx.GetData().(*TaigaTaskData)
+	logger := taskCtx.GetLogger()
+	logger.Info("convert issue labels of project:%d", data.Options.ProjectId)
+
+	for _, source := range issueSources() {
+		if err := convertIssueLabels(taskCtx, source); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func convertIssueLabels(taskCtx plugin.SubTaskContext, source issueSource) errors.Error {
+	data := taskCtx.GetData().(*TaigaTaskData)
+	db := taskCtx.GetDal()
+	// labels carry no date, they follow the items they belong to
+	clauses := []dal.Clause{
+		dal.Select("l.*"),
+		dal.From("_tool_taiga_issue_labels l"),
+		dal.Join(fmt.Sprintf("JOIN %s i ON i.connection_id = l.connection_id AND i.%s = l.item_id", source.toolTable, source.idColumn)),
+		dal.Where("l.connection_id = ? AND l.project_id = ? AND l.item_type = ?",
+			data.Options.ConnectionId, data.Options.ProjectId, source.itemType),
+	}
+	clauses = append(clauses, timeAfterClauses(data, "i.modified_date")...)
+	cursor, err := db.Cursor(clauses...)
+	if err != nil {
+		return err
+	}
+	defer cursor.Close()
+
+	converter, err := api.NewDataConverter(api.DataConverterArgs{
+		RawDataSubTaskArgs: api.RawDataSubTaskArgs{
+			Ctx: taskCtx,
+			Params: TaigaApiParams{
+				ConnectionId: data.Options.ConnectionId,
+				ProjectId:    data.Options.ProjectId,
+			},
+			Table: source.rawTable,
+		},
+		InputRowType: reflect.TypeOf(models.TaigaIssueLabel{}),
+		Input:        cursor,
+		Convert: func(inputRow interface{}) ([]interface{}, errors.Error) {
+			label := inputRow.(*models.TaigaIssueLabel)
+			issueLabel := &ticket.IssueLabel{
+				IssueId:   source.issueIdGen.Generate(label.ConnectionId, label.ItemId),
+				LabelName: label.LabelName,
+			}
+			return []interface{}{issueLabel}, nil
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	return converter.Execute()
+}
@@ -0,0 +1,117 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/apache/incubator-devlake/core/dal"
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/models/domainlayer/ticket"
+	"github.com/irfanuddinahmad/taiga-devlake-plugin/plugins/taiga/models"
+)
+
+// extractLabels turns the tags of an item, pairs of name and optional color, into labels
+func extractLabels(tags [][]*string, itemType string, itemId uint64, data *TaigaTaskData) []*models.TaigaIssueLabel {
+	labels := make([]*models.TaigaIssueLabel, 0, len(tags))
+	seen := make(map[string]bool, len(tags))
+	for _, tag := range tags {
+		if len(tag) == 0 || tag[0] == nil {
+			continue
+		}
+		name := strings.TrimSpace(*tag[0])
+		if name == "" || seen[name] {
+			continue
+		}
+		seen[name] = true
+		label := &models.TaigaIssueLabel{
+			ConnectionId: data.Options.ConnectionId,
+			ItemType:     itemType,
+			ItemId:       itemId,
+			LabelName:    name,
+			ProjectId:    data.Options.ProjectId,
+		}
+		if len(tag) > 1 && tag[1] != nil {
+			label.Color = *tag[1]
+		}
+		labels = append(labels, label)
+	}
+	return labels
+}
+
+// loadLabels returns the label names of the project's items of one type, keyed by item id
+func loadLabels(db dal.Dal, data *TaigaTaskData, itemType string) (map[uint64][]string, errors.Error) {
+	var labels []models.TaigaIssueLabel
+	err := db.All(
+		&labels,
+		dal.Where("connection_id = ? AND project_id = ? AND item_type = ?",
+			data.Options.ConnectionId, data.Options.ProjectId, itemType),
+	)
+	if err != nil {
+		return nil, err
+	}
+	names := make(map[uint64][]string)
+	for _, label := range labels {
+		names[label.ItemId] = append(names[label.ItemId], label.LabelName)
+	}
+	return names, nil
+}
+
+// issueTypeRules derives a standard issue type from labels, see TaigaScopeConfig.IssueTypeBug and friends
+type issueTypeRules struct {
+	types    []string
+	patterns []*regexp.Regexp
+}
+
+func newIssueTypeRules(scopeConfig *models.TaigaScopeConfig) (*issueTypeRules, errors.Error) {
+	rules := &issueTypeRules{}
+	// the most specific type wins when an item carries tags of several types
+	for _, rule := range []struct {
+		issueType string
+		pattern   string
+	}{
+		{ticket.INCIDENT, scopeConfig.IssueTypeIncident},
+		{ticket.BUG, scopeConfig.IssueTypeBug},
+		{ticket.REQUIREMENT, scopeConfig.IssueTypeRequirement},
+	} {
+		if rule.pattern == "" {
+			continue
+		}
+		pattern, err := regexp.Compile(rule.pattern)
+		if err != nil {
+			return nil, errors.BadInput.Wrap(err, fmt.Sprintf("invalid pattern for %s", rule.issueType))
+		}
+		rules.types = append(rules.types, rule.issueType)
+		rules.patterns = append(rules.patterns, pattern)
+	}
+	return rules, nil
+}
+
+// match returns the issue type of the first rule matching any label, or an empty string
+func (r *issueTypeRules) match(labels []string) string {
+	for i, pattern := range r.patterns {
+		for _, label := range labels {
+			if pattern.MatchString(label) {
+				return r.types[i]
+			}
+		}
+	}
+	return ""
+}
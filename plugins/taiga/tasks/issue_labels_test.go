@@ -0,0 +1,89 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"testing"
+
+	"github.com/apache/incubator-devlake/core/models/domainlayer/ticket"
+	"github.com/irfanuddinahmad/taiga-devlake-plugin/plugins/taiga/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func stringPtr(value string) *string {
+	return &value
+}
+
+func TestExtractLabels(t *testing.T) {
+	data := &TaigaTaskData{Options: &TaigaOptions{ConnectionId: 1, ProjectId: 2}}
+	tests := []struct {
+		name string
+		tags [][]*string
+		want []*models.TaigaIssueLabel
+	}{
+		{"no tags", nil, []*models.TaigaIssueLabel{}},
+		{"name and color", [][]*string{{stringPtr("frontend"), stringPtr("#70728F")}}, []*models.TaigaIssueLabel{
+			{ConnectionId: 1, ItemType: models.ItemTypeTask, ItemId: 7, LabelName: "frontend", ProjectId: 2, Color: "#70728F"},
+		}},
+		{"name without color", [][]*string{{stringPtr("backend"), nil}, {stringPtr("api")}}, []*models.TaigaIssueLabel{
+			{ConnectionId: 1, ItemType: models.ItemTypeTask, ItemId: 7, LabelName: "backend", ProjectId: 2},
+			{ConnectionId: 1, ItemType: models.ItemTypeTask, ItemId: 7, LabelName: "api", ProjectId: 2},
+		}},
+		{"blank, missing and duplicate names are skipped", [][]*string{{}, {nil}, {stringPtr("  ")}, {stringPtr(" ux ")}, {stringPtr("ux")}}, []*models.TaigaIssueLabel{
+			{ConnectionId: 1, ItemType: models.ItemTypeTask, ItemId: 7, LabelName: "ux", ProjectId: 2},
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, extractLabels(tt.tags, models.ItemTypeTask, 7, data))
+		})
+	}
+}
+
+func TestIssueTypeRules(t *testing.T) {
+	rules, err := newIssueTypeRules(&models.TaigaScopeConfig{
+		IssueTypeRequirement: "^(feature|story)$",
+		IssueTypeBug:         "(?i)bug",
+		IssueTypeIncident:    "^incident$",
+	})
+	assert.Nil(t, err)
+	tests := []struct {
+		name   string
+		labels []string
+		want   string
+	}{
+		{"no labels", nil, ""},
+		{"no match", []string{"frontend"}, ""},
+		{"requirement", []string{"feature"}, ticket.REQUIREMENT},
+		{"case insensitive pattern", []string{"UI-Bug"}, ticket.BUG},
+		{"bug wins over requirement", []string{"story", "bug"}, ticket.BUG},
+		{"incident wins over bug", []string{"bug", "incident"}, ticket.INCIDENT},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, rules.match(tt.labels))
+		})
+	}
+
+	empty, err := newIssueTypeRules(&models.TaigaScopeConfig{})
+	assert.Nil(t, err)
+	assert.Equal(t, "", empty.match([]string{"bug"}))
+
+	_, err = newIssueTypeRules(&models.TaigaScopeConfig{IssueTypeBug: "("})
+	assert.NotNil(t, err)
+}
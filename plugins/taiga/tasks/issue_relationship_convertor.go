@@ -0,0 +1,123 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"reflect"
+
+	"github.com/apache/incubator-devlake/core/dal"
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/models/domainlayer/didgen"
+	"github.com/apache/incubator-devlake/core/models/domainlayer/ticket"
+	"github.com/apache/incubator-devlake/core/plugin"
+	"github.com/apache/incubator-devlake/helpers/pluginhelper/api"
+	"github.com/irfanuddinahmad/taiga-devlake-plugin/plugins/taiga/models"
+)
+
+var ConvertIssueRelationshipsMeta = plugin.SubTaskMeta{
+	Name:             "convertIssueRelationships",
+	EntryPoint:       ConvertIssueRelationships,
+	EnabledByDefault: true,
+	Description:      "convert links between Taiga user stories and tasks into issue relationships",
+	DomainTypes:      []string{plugin.DOMAIN_TYPE_TICKET},
+	Dependencies: []*plugin.SubTaskMeta{
+		&ExtractUserStoriesMeta,
+		&ExtractTasksMeta,
+		&ExtractCustomAttributeValuesMeta,
+		&ExtractTaskCustomAttributeValuesMeta,
+	},
+}
+
+// relationshipRawTables are the raw tables links are extracted from, user stories link to what they
+// were promoted from, tasks to their story and custom attribute values to the item duplicated
+var relationshipRawTables = []string{
+	RAW_USER_STORY_TABLE,
+	RAW_TASK_TABLE,
+	RAW_CUSTOM_ATTRIBUTE_VALUE_TABLE,
+	RAW_TASK_CUSTOM_ATTRIBUTE_VALUE_TABLE,
+}
+
+func ConvertIssueRelationships(taskCtx plugin.SubTaskContext) errors.Error {
+	data := taskCtx.GetData().(*TaigaTaskData)
+	logger := taskCtx.GetLogger()
+	logger.Info("convert issue relationships of project:%d", data.Options.ProjectId)
+
+	// only user stories and tasks become issues, links to Taiga issues and epics stay in the tool layer
+	// so that no relationship points at an issue that does not exist
+	issueIdGens := map[string]*didgen.DomainIdGenerator{}
+	for _, source := range issueSources() {
+		issueIdGens[source.itemType] = source.issueIdGen
+	}
+	for _, table := range relationshipRawTables {
+		err := convertIssueRelationships(taskCtx, table, issueIdGens)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// convertIssueRelationships converts the links extracted from one raw table
+func convertIssueRelationships(taskCtx plugin.SubTaskContext, table string, issueIdGens map[string]*didgen.DomainIdGenerator) errors.Error {
+	data := taskCtx.GetData().(*TaigaTaskData)
+	db := taskCtx.GetDal()
+	clauses := []dal.Clause{
+		dal.Select("*"),
+		dal.From(&models.TaigaItemRelationship{}),
+		dal.Where("connection_id = ? AND project_id = ? AND _raw_data_table = ?",
+			data.Options.ConnectionId, data.Options.ProjectId, table),
+	}
+	cursor, err := db.Cursor(clauses...)
+	if err != nil {
+		return err
+	}
+	defer cursor.Close()
+
+	converter, err := api.NewDataConverter(api.DataConverterArgs{
+		RawDataSubTaskArgs: api.RawDataSubTaskArgs{
+			Ctx: taskCtx,
+			Params: TaigaApiParams{
+				ConnectionId: data.Options.ConnectionId,
+				ProjectId:    data.Options.ProjectId,
+			},
+			Table: table,
+		},
+		InputRowType: reflect.TypeOf(models.TaigaItemRelationship{}),
+		Input:        cursor,
+		Convert: func(inputRow interface{}) ([]interface{}, errors.Error) {
+			relationship := inputRow.(*models.TaigaItemRelationship)
+			sourceIdGen, sourceOk := issueIdGens[relationship.SourceItemType]
+			targetIdGen, targetOk := issueIdGens[relationship.TargetItemType]
+			if !sourceOk || !targetOk {
+				return nil, nil
+			}
+			return []interface{}{
+				&ticket.IssueRelationship{
+					SourceIssueId: sourceIdGen.Generate(relationship.ConnectionId, relationship.SourceItemId),
+					TargetIssueId: targetIdGen.Generate(relationship.ConnectionId, relationship.TargetItemId),
+					OriginalType:  relationship.RelationshipType,
+				},
+			}, nil
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	return converter.Execute()
+}
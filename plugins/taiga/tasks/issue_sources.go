@@ -0,0 +1,57 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"github.com/apache/incubator-devlake/core/models/domainlayer/didgen"
+	"github.com/irfanuddinahmad/taiga-devlake-plugin/plugins/taiga/models"
+)
+
+// issueSource is an item type converted into issues, the labels, watchers, comments and changelogs
+// of its items are converted under the raw tables they were extracted from
+type issueSource struct {
+	itemType   string
+	issueIdGen *didgen.DomainIdGenerator
+	// toolTable holds the items, their id is in idColumn
+	toolTable       string
+	idColumn        string
+	rawTable        string
+	historyRawTable string
+}
+
+// issueSources returns the item types converted into issues, user stories and their tasks
+func issueSources() []issueSource {
+	return []issueSource{
+		{
+			itemType:        models.ItemTypeUserStory,
+			issueIdGen:      didgen.NewDomainIdGenerator(&models.TaigaUserStory{}),
+			toolTable:       models.TaigaUserStory{}.TableName(),
+			idColumn:        "user_story_id",
+			rawTable:        RAW_USER_STORY_TABLE,
+			historyRawTable: RAW_USER_STORY_HISTORY_TABLE,
+		},
+		{
+			itemType:        models.ItemTypeTask,
+			issueIdGen:      didgen.NewDomainIdGenerator(&models.TaigaTask{}),
+			toolTable:       models.TaigaTask{}.TableName(),
+			idColumn:        "task_id",
+			rawTable:        RAW_TASK_TABLE,
+			historyRawTable: RAW_TASK_HISTORY_TABLE,
+		},
+	}
+}
@@ -0,0 +1,103 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"encoding/json"
+
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/models/common"
+	"github.com/apache/incubator-devlake/core/plugin"
+	"github.com/apache/incubator-devlake/helpers/pluginhelper/api"
+	"github.com/irfanuddinahmad/taiga-devlake-plugin/plugins/taiga/models"
+)
+
+var _ plugin.SubTaskEntryPoint = ExtractMilestones
+
+var ExtractMilestonesMeta = plugin.SubTaskMeta{
+	Name:             "extractMilestones",
+	EntryPoint:       ExtractMilestones,
+	EnabledByDefault: true,
+	Description:      "extract Taiga milestones",
+	DomainTypes:      []string{plugin.DOMAIN_TYPE_TICKET},
+	Dependencies:     []*plugin.SubTaskMeta{&CollectMilestonesMeta},
+}
+
+func ExtractMilestones(taskCtx plugin.SubTaskContext) errors.Error {
+	data := taskCtx.GetData().(*TaigaTaskData)
+	extractor, err := api.NewApiExtractor(api.ApiExtractorArgs{
+		RawDataSubTaskArgs: api.RawDataSubTaskArgs{
+			Ctx: taskCtx,
+			Params: TaigaApiParams{
+				ConnectionId: data.Options.ConnectionId,
+				ProjectId:    data.Options.ProjectId,
+			},
+			Table: RAW_MILESTONE_TABLE,
+		},
+		Extract: func(row *api.RawData) ([]interface{}, errors.Error) {
+			var apiMilestone struct {
+				Id              uint64              `json:"id"`
+				Name            string              `json:"name"`
+				Slug            string              `json:"slug"`
+				EstimatedStart  string              `json:"estimated_start"`
+				EstimatedFinish string              `json:"estimated_finish"`
+				Closed          bool                `json:"closed"`
+				TotalPoints     *float64            `json:"total_points"`
+				ClosedPoints    *float64            `json:"closed_points"`
+				CreatedDate     *common.Iso8601Time `json:"created_date"`
+				ModifiedDate    *common.Iso8601Time `json:"modified_date"`
+			}
+			err := json.Unmarshal(row.Data, &apiMilestone)
+			if err != nil {
+				return nil, errors.Default.Wrap(err, "error unmarshalling milestone")
+			}
+
+			milestone := &models.TaigaMilestone{
+				ConnectionId: data.Options.ConnectionId,
+				MilestoneId:  apiMilestone.Id,
+				ProjectId:    data.Options.ProjectId,
+				Name:         apiMilestone.Name,
+				Slug:         apiMilestone.Slug,
+				Closed:       apiMilestone.Closed,
+				CreatedDate:  apiMilestone.CreatedDate.ToNullableTime(),
+				ModifiedDate: apiMilestone.ModifiedDate.ToNullableTime(),
+			}
+			if apiMilestone.TotalPoints != nil {
+				milestone.TotalPoints = *apiMilestone.TotalPoints
+			}
+			if apiMilestone.ClosedPoints != nil {
+				milestone.ClosedPoints = *apiMilestone.ClosedPoints
+			}
+			var dateErr errors.Error
+			if milestone.EstimatedStart, dateErr = parseTaigaDate(apiMilestone.EstimatedStart); dateErr != nil {
+				return nil, dateErr
+			}
+			if milestone.EstimatedFinish, dateErr = parseTaigaDate(apiMilestone.EstimatedFinish); dateErr != nil {
+				return nil, dateErr
+			}
+
+			return []interface{}{milestone}, nil
+		},
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return extractor.Execute()
+}
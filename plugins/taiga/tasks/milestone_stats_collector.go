@@ -0,0 +1,103 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"time"
+
+	"github.com/apache/incubator-devlake/core/dal"
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/plugin"
+	"github.com/apache/incubator-devlake/helpers/pluginhelper/api"
+	"github.com/irfanuddinahmad/taiga-devlake-plugin/plugins/taiga/models"
+)
+
+const RAW_MILESTONE_STATS_TABLE = "taiga_api_milestone_stats"
+
+// closed milestones keep their stats collected for this long after their estimated finish
+const recentMilestoneDays = 30
+
+var _ plugin.SubTaskEntryPoint = CollectMilestoneStats
+
+var CollectMilestoneStatsMeta = plugin.SubTaskMeta{
+	Name:             "collectMilestoneStats",
+	EntryPoint:       skipOnReplay(CollectMilestoneStats),
+	EnabledByDefault: true,
+	Description:      "collect burndown stats of open and recently closed Taiga milestones",
+	DomainTypes:      []string{plugin.DOMAIN_TYPE_TICKET},
+	Dependencies:     []*plugin.SubTaskMeta{&ExtractMilestonesMeta},
+}
+
+type SimpleMilestone struct {
+	MilestoneId uint64
+}
+
+func CollectMilestoneStats(taskCtx plugin.SubTaskContext) errors.Error {
+	data := taskCtx.GetData().(*TaigaTaskData)
+	logger := taskCtx.GetLogger()
+	db := taskCtx.GetDal()
+	logger.Info("collect milestone stats")
+
+	recent := time.Now().AddDate(0, 0, -recentMilestoneDays)
+	cursor, err := db.Cursor(
+		dal.Select("milestone_id"),
+		dal.From(&models.TaigaMilestone{}),
+		dal.Where("connection_id = ? AND project_id = ? AND (closed = ? OR estimated_finish >= ?)",
+			data.Options.ConnectionId, data.Options.ProjectId, false, recent),
+	)
+	if err != nil {
+		return err
+	}
+	iterator, err := api.NewDalCursorIterator(db, cursor, reflect.TypeOf(SimpleMilestone{}))
+	if err != nil {
+		return err
+	}
+
+	collector, err := api.NewApiCollector(api.ApiCollectorArgs{
+		RawDataSubTaskArgs: api.RawDataSubTaskArgs{
+			Ctx: taskCtx,
+			Params: TaigaApiParams{
+				ConnectionId: data.Options.ConnectionId,
+				ProjectId:    data.Options.ProjectId,
+			},
+			Table: RAW_MILESTONE_STATS_TABLE,
+		},
+		ApiClient: data.ApiClient,
+		// keep the stats of milestones closed long ago, they are no longer collected
+		Incremental: true,
+		Input:       iterator,
+		UrlTemplate: "milestones/{{ .Input.MilestoneId }}/stats",
+		ResponseParser: func(res *http.Response) ([]json.RawMessage, errors.Error) {
+			var result json.RawMessage
+			err := api.UnmarshalResponse(res, &result)
+			if err != nil {
+				return nil, err
+			}
+			return []json.RawMessage{result}, nil
+		},
+		AfterResponse: withThrottling(data, ignoreHTTPStatus404),
+	})
+	if err != nil {
+		logger.Error(err, "collect milestone stats error")
+		return err
+	}
+	return collector.Execute()
+}
@@ -0,0 +1,114 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"encoding/json"
+
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/plugin"
+	"github.com/apache/incubator-devlake/helpers/pluginhelper/api"
+	"github.com/irfanuddinahmad/taiga-devlake-plugin/plugins/taiga/models"
+)
+
+var _ plugin.SubTaskEntryPoint = ExtractMilestoneStats
+
+var ExtractMilestoneStatsMeta = plugin.SubTaskMeta{
+	Name:             "extractMilestoneStats",
+	EntryPoint:       ExtractMilestoneStats,
+	EnabledByDefault: true,
+	Description:      "extract daily burndown snapshots from Taiga milestone stats",
+	DomainTypes:      []string{plugin.DOMAIN_TYPE_TICKET},
+	Dependencies:     []*plugin.SubTaskMeta{&CollectMilestoneStatsMeta},
+}
+
+// TaigaApiMilestoneStats is the response of api/v1/milestones/{id}/stats
+type TaigaApiMilestoneStats struct {
+	// total points per role id
+	TotalPoints map[string]float64 `json:"total_points"`
+	Days        []struct {
+		Day           string   `json:"day"`
+		OpenPoints    *float64 `json:"open_points"`
+		OptimalPoints *float64 `json:"optimal_points"`
+	} `json:"days"`
+}
+
+func ExtractMilestoneStats(taskCtx plugin.SubTaskContext) errors.Error {
+	data := taskCtx.GetData().(*TaigaTaskData)
+	extractor, err := api.NewApiExtractor(api.ApiExtractorArgs{
+		RawDataSubTaskArgs: api.RawDataSubTaskArgs{
+			Ctx: taskCtx,
+			Params: TaigaApiParams{
+				ConnectionId: data.Options.ConnectionId,
+				ProjectId:    data.Options.ProjectId,
+			},
+			Table: RAW_MILESTONE_STATS_TABLE,
+		},
+		Extract: func(row *api.RawData) ([]interface{}, errors.Error) {
+			var input SimpleMilestone
+			err := json.Unmarshal(row.Input, &input)
+			if err != nil {
+				return nil, errors.Default.Wrap(err, "error unmarshalling milestone stats input")
+			}
+			var stats TaigaApiMilestoneStats
+			err = json.Unmarshal(row.Data, &stats)
+			if err != nil {
+				return nil, errors.Default.Wrap(err, "error unmarshalling milestone stats")
+			}
+
+			var totalPoints float64
+			for _, points := range stats.TotalPoints {
+				totalPoints += points
+			}
+			results := make([]interface{}, 0, len(stats.Days))
+			for _, day := range stats.Days {
+				date, dateErr := parseTaigaDate(day.Day)
+				if dateErr != nil {
+					return nil, dateErr
+				}
+				if date == nil {
+					continue
+				}
+				burndown := &models.TaigaMilestoneBurndown{
+					ConnectionId: data.Options.ConnectionId,
+					MilestoneId:  input.MilestoneId,
+					Day:          *date,
+					ProjectId:    data.Options.ProjectId,
+					TotalPoints:  totalPoints,
+				}
+				// days after today have no open points yet
+				if day.OpenPoints != nil {
+					burndown.OpenPoints = *day.OpenPoints
+					burndown.CompletedPoints = totalPoints - *day.OpenPoints
+				}
+				if day.OptimalPoints != nil {
+					burndown.OptimalPoints = *day.OptimalPoints
+				}
+				results = append(results, burndown)
+			}
+
+			return results, nil
+		},
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return extractor.Execute()
+}
@@ -32,7 +32,7 @@ var _ plugin.SubTaskEntryPoint = CollectProjects
 
 var CollectProjectsMeta = plugin.SubTaskMeta{
 	Name:             "collectProjects",
-	EntryPoint:       CollectProjects,
+	EntryPoint:       skipOnReplay(CollectProjects),
 	EnabledByDefault: true,
 	Description:      "collect Taiga projects",
 	DomainTypes:      []string{plugin.DOMAIN_TYPE_TICKET},
@@ -42,7 +42,7 @@ func CollectProjects(taskCtx plugin.SubTaskContext) errors.Error {
 	data := taskCtx.GetData().(*TaigaTaskData)
 	logger := taskCtx.GetLogger()
 	logger.Info("collect projects")
-	
+
 	collector, err := api.NewApiCollector(api.ApiCollectorArgs{
 		RawDataSubTaskArgs: api.RawDataSubTaskArgs{
 			Ctx: taskCtx,
@@ -52,8 +52,9 @@ func CollectProjects(taskCtx plugin.SubTaskContext) errors.Error {
 			},
 			Table: RAW_PROJECT_TABLE,
 		},
-		ApiClient:   data.ApiClient,
-		UrlTemplate: "api/v1/projects/{{ .Params.ProjectId }}",
+		ApiClient:     data.ApiClient,
+		AfterResponse: withThrottling(data, nil),
+		UrlTemplate:   "projects/{{ .Params.ProjectId }}",
 		ResponseParser: func(res *http.Response) ([]json.RawMessage, errors.Error) {
 			var result json.RawMessage
 			err := api.UnmarshalResponse(res, &result)
@@ -36,6 +36,7 @@ var ConvertProjectsMeta = plugin.SubTaskMeta{
 	EnabledByDefault: true,
 	Description:      "convert Taiga projects",
 	DomainTypes:      []string{plugin.DOMAIN_TYPE_TICKET},
+	Dependencies:     []*plugin.SubTaskMeta{&ExtractProjectsMeta},
 }
 
 func ConvertProjects(taskCtx plugin.SubTaskContext) errors.Error {
@@ -74,6 +75,7 @@ func ConvertProjects(taskCtx plugin.SubTaskContext) errors.Error {
 				Name:         project.Name,
 				Description:  project.Description,
 				Url:          project.Url,
+				Type:         project.BoardType(),
 			}
 			return []interface{}{
 				domainBoard,
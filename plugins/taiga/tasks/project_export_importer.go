@@ -0,0 +1,458 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"strings"
+	"time"
+
+	"github.com/apache/incubator-devlake/core/dal"
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/helpers/pluginhelper/api"
+)
+
+// TaigaProjectExport is the part of a file from Taiga's "export project" feature the importer reads.
+// Exports carry no ids, they identify users by email, items by ref and the rest by slug or name,
+// the importer derives ids from those, see importedId.
+type TaigaProjectExport struct {
+	Name        string `json:"name"`
+	Slug        string `json:"slug"`
+	Description string `json:"description"`
+	Roles       []struct {
+		Name       string `json:"name"`
+		Slug       string `json:"slug"`
+		Computable bool   `json:"computable"`
+		Order      int    `json:"order"`
+	} `json:"roles"`
+	Points []struct {
+		Name  string   `json:"name"`
+		Value *float64 `json:"value"`
+		Order int      `json:"order"`
+	} `json:"points"`
+	Memberships []struct {
+		User  *string `json:"user"`
+		Email string  `json:"email"`
+	} `json:"memberships"`
+	Milestones []struct {
+		Name            string          `json:"name"`
+		Slug            string          `json:"slug"`
+		EstimatedStart  string          `json:"estimated_start"`
+		EstimatedFinish string          `json:"estimated_finish"`
+		Closed          bool            `json:"closed"`
+		CreatedDate     json.RawMessage `json:"created_date"`
+		ModifiedDate    json.RawMessage `json:"modified_date"`
+	} `json:"milestones"`
+	TaskStatuses []struct {
+		Name     string `json:"name"`
+		Slug     string `json:"slug"`
+		IsClosed bool   `json:"is_closed"`
+		Order    int    `json:"order"`
+	} `json:"task_statuses"`
+	UserStories []taigaExportUserStory `json:"user_stories"`
+	Tasks       []taigaExportTask      `json:"tasks"`
+	// not collected by the plugin, only counted
+	Issues []json.RawMessage `json:"issues"`
+	Epics  []json.RawMessage `json:"epics"`
+}
+
+type taigaExportUserStory struct {
+	Ref           int             `json:"ref"`
+	Subject       string          `json:"subject"`
+	Status        string          `json:"status"`
+	CreatedDate   json.RawMessage `json:"created_date"`
+	ModifiedDate  json.RawMessage `json:"modified_date"`
+	FinishDate    json.RawMessage `json:"finish_date"`
+	IsClosed      bool            `json:"is_closed"`
+	IsBlocked     bool            `json:"is_blocked"`
+	BlockedNote   string          `json:"blocked_note"`
+	DueDate       *string         `json:"due_date"`
+	DueDateReason string          `json:"due_date_reason"`
+	AssignedTo    *string         `json:"assigned_to"`
+	Milestone     *string         `json:"milestone"`
+	Tags          []string        `json:"tags"`
+	Watchers      []string        `json:"watchers"`
+	RolePoints    []struct {
+		Role   string `json:"role"`
+		Points string `json:"points"`
+	} `json:"role_points"`
+	History []taigaExportHistoryEntry `json:"history"`
+}
+
+type taigaExportTask struct {
+	Ref          int             `json:"ref"`
+	Subject      string          `json:"subject"`
+	Status       string          `json:"status"`
+	CreatedDate  json.RawMessage `json:"created_date"`
+	ModifiedDate json.RawMessage `json:"modified_date"`
+	FinishedDate json.RawMessage `json:"finished_date"`
+	AssignedTo   *string         `json:"assigned_to"`
+	// ref of the user story
+	UserStory *int                      `json:"user_story"`
+	Milestone *string                   `json:"milestone"`
+	Tags      []string                  `json:"tags"`
+	Watchers  []string                  `json:"watchers"`
+	History   []taigaExportHistoryEntry `json:"history"`
+}
+
+type taigaExportHistoryEntry struct {
+	// email and full name
+	User              []string                   `json:"user"`
+	CreatedAt         json.RawMessage            `json:"created_at"`
+	Type              int                        `json:"type"`
+	Comment           string                     `json:"comment"`
+	EditCommentDate   json.RawMessage            `json:"edit_comment_date"`
+	DeleteCommentDate json.RawMessage            `json:"delete_comment_date"`
+	ValuesDiff        map[string]json.RawMessage `json:"values_diff"`
+}
+
+// TaigaImportResult counts the raw rows written per raw table and the exported items left out
+type TaigaImportResult struct {
+	RawRows map[string]int `json:"rawRows"`
+	Skipped map[string]int `json:"skipped"`
+}
+
+// importedIdBit is set on every imported id, the ids of the Taiga API stay far below it so imported
+// items never take the id of an item collected from the API, and the ids still fit a signed bigint
+const importedIdBit = 1 << 62
+
+// importedId derives an id from the kind of an exported item and the values the export keeps for it,
+// e.g. the email of a user or the project and ref of a story, so re-importing a re-ordered export
+// gives every item the id it had before
+func importedId(kind string, key string) uint64 {
+	hash := fnv.New64a()
+	_, _ = hash.Write([]byte(kind + "/" + key))
+	return importedIdBit | hash.Sum64()&(importedIdBit-1)
+}
+
+type rawRow struct {
+	data  interface{}
+	input interface{}
+}
+
+// ImportProjectExport writes a Taiga project export into the raw tables the collectors fill, so the
+// extractors and convertors can run on it as if the project had been collected from the API
+func ImportProjectExport(db dal.Dal, connectionId uint64, projectId uint64, export *TaigaProjectExport) (*TaigaImportResult, errors.Error) {
+	// users are shared by the projects of a connection, items belong to the project
+	projectItemId := func(kind string, key interface{}) uint64 {
+		return importedId(kind, fmt.Sprintf("%d/%v", projectId, key))
+	}
+	accountIds := make(map[string]uint64)
+	accountNames := make(map[string]string)
+	accountId := func(email *string) *uint64 {
+		if email == nil || *email == "" {
+			return nil
+		}
+		id, ok := accountIds[*email]
+		if !ok {
+			id = importedId("account", strings.ToLower(strings.TrimSpace(*email)))
+			accountIds[*email] = id
+		}
+		return &id
+	}
+
+	for _, membership := range export.Memberships {
+		email := membership.User
+		if email == nil {
+			email = &membership.Email
+		}
+		accountId(email)
+	}
+
+	roleIds := make(map[string]uint64, len(export.Roles))
+	roles := make([]map[string]interface{}, 0, len(export.Roles))
+	for _, role := range export.Roles {
+		roleIds[role.Name] = projectItemId("role", role.Slug)
+		roles = append(roles, map[string]interface{}{
+			"id":         roleIds[role.Name],
+			"name":       role.Name,
+			"slug":       role.Slug,
+			"computable": role.Computable,
+			"order":      role.Order,
+		})
+	}
+	pointIds := make(map[string]uint64, len(export.Points))
+	points := make([]map[string]interface{}, 0, len(export.Points))
+	for _, point := range export.Points {
+		pointIds[point.Name] = projectItemId("point", point.Name)
+		points = append(points, map[string]interface{}{
+			"id":    pointIds[point.Name],
+			"name":  point.Name,
+			"value": point.Value,
+			"order": point.Order,
+		})
+	}
+	project := []rawRow{{data: map[string]interface{}{
+		"id":          projectId,
+		"name":        export.Name,
+		"slug":        export.Slug,
+		"description": export.Description,
+		"roles":       roles,
+		"points":      points,
+	}}}
+
+	milestoneIds := make(map[string]uint64, len(export.Milestones))
+	milestones := make([]rawRow, 0, len(export.Milestones))
+	for _, milestone := range export.Milestones {
+		milestoneIds[milestone.Name] = projectItemId("milestone", milestone.Slug)
+		milestones = append(milestones, rawRow{data: map[string]interface{}{
+			"id":               milestoneIds[milestone.Name],
+			"name":             milestone.Name,
+			"slug":             milestone.Slug,
+			"estimated_start":  milestone.EstimatedStart,
+			"estimated_finish": milestone.EstimatedFinish,
+			"closed":           milestone.Closed,
+			"created_date":     milestone.CreatedDate,
+			"modified_date":    milestone.ModifiedDate,
+		}})
+	}
+
+	milestoneId := func(name *string) *uint64 {
+		if name == nil {
+			return nil
+		}
+		if id, ok := milestoneIds[*name]; ok {
+			return &id
+		}
+		return nil
+	}
+	// exported tags have no color
+	importTags := func(names []string) [][]*string {
+		tags := make([][]*string, 0, len(names))
+		for i := range names {
+			tags = append(tags, []*string{&names[i], nil})
+		}
+		return tags
+	}
+	importWatchers := func(emails []string) []uint64 {
+		watchers := make([]uint64, 0, len(emails))
+		for i := range emails {
+			if id := accountId(&emails[i]); id != nil {
+				watchers = append(watchers, *id)
+			}
+		}
+		return watchers
+	}
+	importHistory := func(entries []taigaExportHistoryEntry, itemId uint64, input interface{}) []rawRow {
+		histories := make([]rawRow, 0, len(entries))
+		for i, entry := range entries {
+			user := map[string]interface{}{"pk": 0}
+			if len(entry.User) > 0 {
+				if id := accountId(&entry.User[0]); id != nil {
+					user["pk"] = *id
+				}
+				user["username"] = entry.User[0]
+				user["name"] = entry.User[len(entry.User)-1]
+				accountNames[entry.User[0]] = entry.User[len(entry.User)-1]
+			}
+			histories = append(histories, rawRow{
+				data: map[string]interface{}{
+					"id":                  fmt.Sprintf("import-%d-%d", itemId, i),
+					"user":                user,
+					"created_at":          entry.CreatedAt,
+					"type":                entry.Type,
+					"comment":             entry.Comment,
+					"edit_comment_date":   entry.EditCommentDate,
+					"delete_comment_date": entry.DeleteCommentDate,
+					"values_diff":         entry.ValuesDiff,
+				},
+				input: input,
+			})
+		}
+		return histories
+	}
+
+	stories := make([]rawRow, 0, len(export.UserStories))
+	var storyHistories []rawRow
+	for _, story := range export.UserStories {
+		storyId := projectItemId("userstory", story.Ref)
+		storyPoints := make(map[string]uint64, len(story.RolePoints))
+		for _, rolePoint := range story.RolePoints {
+			roleId, roleOk := roleIds[rolePoint.Role]
+			pointId, pointOk := pointIds[rolePoint.Points]
+			if roleOk && pointOk {
+				storyPoints[fmt.Sprintf("%d", roleId)] = pointId
+			}
+		}
+		stories = append(stories, rawRow{data: map[string]interface{}{
+			"id":                storyId,
+			"ref":               story.Ref,
+			"subject":           story.Subject,
+			"status_extra_info": map[string]string{"name": story.Status},
+			"created_date":      story.CreatedDate,
+			"modified_date":     story.ModifiedDate,
+			"finish_date":       story.FinishDate,
+			"is_closed":         story.IsClosed,
+			"is_blocked":        story.IsBlocked,
+			"blocked_note":      story.BlockedNote,
+			"due_date":          story.DueDate,
+			"due_date_reason":   story.DueDateReason,
+			"assigned_to":       accountId(story.AssignedTo),
+			"milestone":         milestoneId(story.Milestone),
+			"points":            storyPoints,
+			"tags":              importTags(story.Tags),
+			"watchers":          importWatchers(story.Watchers),
+		}})
+
+		storyHistories = append(storyHistories, importHistory(story.History, storyId, SimpleUserStory{UserStoryId: storyId})...)
+	}
+
+	taskStatusClosed := make(map[string]bool, len(export.TaskStatuses))
+	taskStatuses := make([]rawRow, 0, len(export.TaskStatuses))
+	for _, status := range export.TaskStatuses {
+		taskStatusClosed[status.Name] = status.IsClosed
+		taskStatuses = append(taskStatuses, rawRow{data: map[string]interface{}{
+			"id":        projectItemId("taskstatus", status.Slug),
+			"name":      status.Name,
+			"slug":      status.Slug,
+			"is_closed": status.IsClosed,
+			"order":     status.Order,
+		}})
+	}
+
+	tasks := make([]rawRow, 0, len(export.Tasks))
+	var taskHistories []rawRow
+	for _, task := range export.Tasks {
+		taskId := projectItemId("task", task.Ref)
+		var storyId *uint64
+		if task.UserStory != nil {
+			id := projectItemId("userstory", *task.UserStory)
+			storyId = &id
+		}
+		tasks = append(tasks, rawRow{data: map[string]interface{}{
+			"id":                taskId,
+			"ref":               task.Ref,
+			"subject":           task.Subject,
+			"user_story":        storyId,
+			"milestone":         milestoneId(task.Milestone),
+			"is_closed":         taskStatusClosed[task.Status],
+			"assigned_to":       accountId(task.AssignedTo),
+			"status_extra_info": map[string]string{"name": task.Status},
+			"created_date":      task.CreatedDate,
+			"modified_date":     task.ModifiedDate,
+			"finished_date":     task.FinishedDate,
+			"tags":              importTags(task.Tags),
+			"watchers":          importWatchers(task.Watchers),
+		}})
+		taskHistories = append(taskHistories, importHistory(task.History, taskId, SimpleTask{TaskId: taskId})...)
+	}
+
+	// items and histories may name users who have left the project, so accounts come last
+	accounts := make([]rawRow, 0, len(accountIds))
+	for email, id := range accountIds {
+		name := accountNames[email]
+		if name == "" {
+			name = email
+		}
+		accounts = append(accounts, rawRow{data: map[string]interface{}{
+			"id":                id,
+			"username":          email,
+			"full_name_display": name,
+			"email":             email,
+			"is_active":         true,
+		}})
+	}
+
+	// accounts are shared by the projects of a connection and extracted by its catalog task, which
+	// has no project
+	projectParams, jsonErr := json.Marshal(TaigaApiParams{ConnectionId: connectionId, ProjectId: projectId})
+	if jsonErr != nil {
+		return nil, errors.Default.Wrap(jsonErr, "error marshalling raw data params")
+	}
+	connectionParams, jsonErr := json.Marshal(TaigaApiParams{ConnectionId: connectionId})
+	if jsonErr != nil {
+		return nil, errors.Default.Wrap(jsonErr, "error marshalling raw data params")
+	}
+	tables := []struct {
+		table  string
+		params []byte
+		// rows of other projects share the params, only the rows of this export are replaced
+		shared bool
+		rows   []rawRow
+	}{
+		{RAW_PROJECT_TABLE, projectParams, false, project},
+		{RAW_ACCOUNT_TABLE, connectionParams, true, accounts},
+		{RAW_MILESTONE_TABLE, projectParams, false, milestones},
+		{RAW_USER_STORY_TABLE, projectParams, false, stories},
+		{RAW_USER_STORY_HISTORY_TABLE, projectParams, false, storyHistories},
+		{RAW_TASK_STATUS_TABLE, projectParams, false, taskStatuses},
+		{RAW_TASK_TABLE, projectParams, false, tasks},
+		{RAW_TASK_HISTORY_TABLE, projectParams, false, taskHistories},
+	}
+	result := &TaigaImportResult{
+		RawRows: make(map[string]int, len(tables)),
+		Skipped: map[string]int{
+			"issues": len(export.Issues),
+			"epics":  len(export.Epics),
+		},
+	}
+	source := fmt.Sprintf("taiga-export/%d", projectId)
+	for _, t := range tables {
+		err := writeRawTable(db, "_raw_"+t.table, string(t.params), source, t.shared, t.rows)
+		if err != nil {
+			return nil, err
+		}
+		result.RawRows[t.table] = len(t.rows)
+	}
+	return result, nil
+}
+
+// writeRawTable replaces the raw rows stored under params in a raw table, or only the ones written
+// from source when onlySource is set
+func writeRawTable(db dal.Dal, table string, params string, source string, onlySource bool, rows []rawRow) errors.Error {
+	err := db.AutoMigrate(&api.RawData{}, dal.From(table))
+	if err != nil {
+		return err
+	}
+	where := dal.Where("params = ?", params)
+	if onlySource {
+		where = dal.Where("params = ? AND url = ?", params, source)
+	}
+	err = db.Delete(&api.RawData{}, dal.From(table), where)
+	if err != nil {
+		return err
+	}
+	if len(rows) == 0 {
+		return nil
+	}
+	now := time.Now()
+	rawData := make([]*api.RawData, 0, len(rows))
+	for _, row := range rows {
+		data, jsonErr := json.Marshal(row.data)
+		if jsonErr != nil {
+			return errors.Default.Wrap(jsonErr, "error marshalling imported item")
+		}
+		var input json.RawMessage
+		if row.input != nil {
+			input, jsonErr = json.Marshal(row.input)
+			if jsonErr != nil {
+				return errors.Default.Wrap(jsonErr, "error marshalling imported item input")
+			}
+		}
+		rawData = append(rawData, &api.RawData{
+			Params:    params,
+			Data:      data,
+			Url:       source,
+			Input:     input,
+			CreatedAt: now,
+		})
+	}
+	return db.Create(rawData, dal.From(table))
+}
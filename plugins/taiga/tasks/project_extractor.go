@@ -34,6 +34,7 @@ var ExtractProjectsMeta = plugin.SubTaskMeta{
 	EnabledByDefault: true,
 	Description:      "extract Taiga projects",
 	DomainTypes:      []string{plugin.DOMAIN_TYPE_TICKET},
+	Dependencies:     []*plugin.SubTaskMeta{&CollectProjectsMeta},
 }
 
 func ExtractProjects(taskCtx plugin.SubTaskContext) errors.Error {
@@ -49,29 +50,77 @@ func ExtractProjects(taskCtx plugin.SubTaskContext) errors.Error {
 		},
 		Extract: func(row *api.RawData) ([]interface{}, errors.Error) {
 			var apiProject struct {
-				Id          uint64 `json:"id"`
-				Name        string `json:"name"`
-				Slug        string `json:"slug"`
-				Description string `json:"description"`
-				CreatedDate string `json:"created_date"`
+				Id           uint64 `json:"id"`
+				Name         string `json:"name"`
+				Slug         string `json:"slug"`
+				Description  string `json:"description"`
+				CreatedDate  string `json:"created_date"`
 				ModifiedDate string `json:"modified_date"`
+				// the backlog makes a scrum project, the kanban board a kanban one
+				IsBacklogActivated bool `json:"is_backlog_activated"`
+				IsKanbanActivated  bool `json:"is_kanban_activated"`
+				Roles              []struct {
+					Id         uint64 `json:"id"`
+					Name       string `json:"name"`
+					Slug       string `json:"slug"`
+					Computable bool   `json:"computable"`
+					Order      int    `json:"order"`
+				} `json:"roles"`
+				Points []struct {
+					Id    uint64   `json:"id"`
+					Name  string   `json:"name"`
+					Value *float64 `json:"value"`
+					Order int      `json:"order"`
+				} `json:"points"`
 			}
 			err := json.Unmarshal(row.Data, &apiProject)
 			if err != nil {
 				return nil, errors.Default.Wrap(err, "error unmarshalling project")
 			}
-			
+
 			project := &models.TaigaProject{
-				ProjectId:    apiProject.Id,
-				Name:         apiProject.Name,
-				Slug:         apiProject.Slug,
-				Description:  apiProject.Description,
+				ProjectId:          apiProject.Id,
+				Name:               apiProject.Name,
+				Slug:               apiProject.Slug,
+				Description:        apiProject.Description,
+				IsBacklogActivated: apiProject.IsBacklogActivated,
+				IsKanbanActivated:  apiProject.IsKanbanActivated,
+			}
+			if data.Connection != nil {
+				project.Url = data.Connection.ProjectUrl(apiProject.Slug)
+			}
+			// the project is the scope, keep it attached to its connection and scope config
+			project.ConnectionId = data.Options.ConnectionId
+			project.ScopeConfigId = data.Options.ScopeConfigId
+
+			results := []interface{}{project}
+			// the role and point catalogs are needed to resolve the per role estimates of stories
+			for _, role := range apiProject.Roles {
+				results = append(results, &models.TaigaRole{
+					ConnectionId: data.Options.ConnectionId,
+					RoleId:       role.Id,
+					ProjectId:    apiProject.Id,
+					Name:         role.Name,
+					Slug:         role.Slug,
+					Computable:   role.Computable,
+					Order:        role.Order,
+				})
 			}
-			
-			return []interface{}{project}, nil
+			for _, point := range apiProject.Points {
+				results = append(results, &models.TaigaPoint{
+					ConnectionId: data.Options.ConnectionId,
+					PointId:      point.Id,
+					ProjectId:    apiProject.Id,
+					Name:         point.Name,
+					Value:        point.Value,
+					Order:        point.Order,
+				})
+			}
+
+			return results, nil
 		},
 	})
-	
+
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,100 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/plugin"
+	"github.com/apache/incubator-devlake/helpers/pluginhelper/api"
+)
+
+const RAW_PROJECT_STATS_TABLE = "taiga_api_project_stats"
+
+var _ plugin.SubTaskEntryPoint = CollectProjectStats
+
+var CollectProjectStatsMeta = plugin.SubTaskMeta{
+	Name:             "collectProjectStats",
+	EntryPoint:       skipOnReplay(CollectProjectStats),
+	EnabledByDefault: true,
+	Description:      "collect Taiga project stats and issues stats",
+	DomainTypes:      []string{plugin.DOMAIN_TYPE_TICKET},
+}
+
+// TaigaApiProjectStats pairs the responses of api/v1/projects/{id}/stats and issues_stats taken
+// at the same time
+type TaigaApiProjectStats struct {
+	Stats       json.RawMessage `json:"stats"`
+	IssuesStats json.RawMessage `json:"issues_stats"`
+}
+
+func CollectProjectStats(taskCtx plugin.SubTaskContext) errors.Error {
+	data := taskCtx.GetData().(*TaigaTaskData)
+	logger := taskCtx.GetLogger()
+	logger.Info("collect project stats")
+
+	collector, err := api.NewApiCollector(api.ApiCollectorArgs{
+		RawDataSubTaskArgs: api.RawDataSubTaskArgs{
+			Ctx: taskCtx,
+			Params: TaigaApiParams{
+				ConnectionId: data.Options.ConnectionId,
+				ProjectId:    data.Options.ProjectId,
+			},
+			Table: RAW_PROJECT_STATS_TABLE,
+		},
+		ApiClient: data.ApiClient,
+		// every run appends a snapshot, the earlier ones must be kept
+		Incremental: true,
+		UrlTemplate: "projects/{{ .Params.ProjectId }}/stats",
+		ResponseParser: func(res *http.Response) ([]json.RawMessage, errors.Error) {
+			var result TaigaApiProjectStats
+			err := api.UnmarshalResponse(res, &result.Stats)
+			if err != nil {
+				return nil, err
+			}
+			// issues_stats is fetched alongside so both halves of a snapshot share one raw row
+			issuesRes, err := data.ApiClient.Get(fmt.Sprintf("projects/%d/issues_stats", data.Options.ProjectId), nil, nil)
+			if err != nil {
+				return nil, err
+			}
+			if issuesRes.StatusCode == http.StatusOK {
+				err = api.UnmarshalResponse(issuesRes, &result.IssuesStats)
+				if err != nil {
+					return nil, err
+				}
+			} else {
+				issuesRes.Body.Close()
+				logger.Warn(nil, "issues stats of project %d unavailable: %s", data.Options.ProjectId, issuesRes.Status)
+			}
+			raw, jsonErr := json.Marshal(result)
+			if jsonErr != nil {
+				return nil, errors.Default.Wrap(jsonErr, "error marshalling project stats")
+			}
+			return []json.RawMessage{raw}, nil
+		},
+		AfterResponse: withThrottling(data, ignoreHTTPStatus404),
+	})
+	if err != nil {
+		logger.Error(err, "collect project stats error")
+		return err
+	}
+	return collector.Execute()
+}
@@ -0,0 +1,148 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/apache/incubator-devlake/core/dal"
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/plugin"
+	"github.com/apache/incubator-devlake/helpers/pluginhelper/api"
+	"github.com/irfanuddinahmad/taiga-devlake-plugin/plugins/taiga/models"
+)
+
+var _ plugin.SubTaskEntryPoint = ExtractProjectStats
+
+var ExtractProjectStatsMeta = plugin.SubTaskMeta{
+	Name:             "extractProjectStats",
+	EntryPoint:       ExtractProjectStats,
+	EnabledByDefault: true,
+	Description:      "extract daily snapshots of Taiga project stats and refresh the project totals",
+	DomainTypes:      []string{plugin.DOMAIN_TYPE_TICKET},
+	Dependencies:     []*plugin.SubTaskMeta{&CollectProjectStatsMeta, &ExtractProjectsMeta},
+}
+
+// taigaApiIssueCounts is a breakdown of issues_stats, keyed by the id of the status, severity...
+type taigaApiIssueCounts map[string]struct {
+	Name  string `json:"name"`
+	Count int    `json:"count"`
+}
+
+func (counts taigaApiIssueCounts) byName() map[string]int {
+	result := make(map[string]int, len(counts))
+	for _, count := range counts {
+		result[count.Name] += count.Count
+	}
+	return result
+}
+
+func ExtractProjectStats(taskCtx plugin.SubTaskContext) errors.Error {
+	data := taskCtx.GetData().(*TaigaTaskData)
+	db := taskCtx.GetDal()
+	extractor, err := api.NewApiExtractor(api.ApiExtractorArgs{
+		RawDataSubTaskArgs: api.RawDataSubTaskArgs{
+			Ctx: taskCtx,
+			Params: TaigaApiParams{
+				ConnectionId: data.Options.ConnectionId,
+				ProjectId:    data.Options.ProjectId,
+			},
+			Table: RAW_PROJECT_STATS_TABLE,
+		},
+		Extract: func(row *api.RawData) ([]interface{}, errors.Error) {
+			var raw TaigaApiProjectStats
+			err := json.Unmarshal(row.Data, &raw)
+			if err != nil {
+				return nil, errors.Default.Wrap(err, "error unmarshalling project stats")
+			}
+			var stats struct {
+				TotalMilestones *int     `json:"total_milestones"`
+				TotalPoints     *float64 `json:"total_points"`
+				DefinedPoints   float64  `json:"defined_points"`
+				AssignedPoints  float64  `json:"assigned_points"`
+				ClosedPoints    float64  `json:"closed_points"`
+				Speed           float64  `json:"speed"`
+			}
+			err = json.Unmarshal(raw.Stats, &stats)
+			if err != nil {
+				return nil, errors.Default.Wrap(err, "error unmarshalling project stats")
+			}
+			var issuesStats struct {
+				TotalIssues       int                 `json:"total_issues"`
+				OpenedIssues      int                 `json:"opened_issues"`
+				ClosedIssues      int                 `json:"closed_issues"`
+				IssuesPerStatus   taigaApiIssueCounts `json:"issues_per_status"`
+				IssuesPerSeverity taigaApiIssueCounts `json:"issues_per_severity"`
+				IssuesPerPriority taigaApiIssueCounts `json:"issues_per_priority"`
+				IssuesPerType     taigaApiIssueCounts `json:"issues_per_type"`
+			}
+			if len(raw.IssuesStats) > 0 {
+				err = json.Unmarshal(raw.IssuesStats, &issuesStats)
+				if err != nil {
+					return nil, errors.Default.Wrap(err, "error unmarshalling project issues stats")
+				}
+			}
+
+			collectedAt := row.CreatedAt.UTC()
+			snapshot := &models.TaigaProjectStatsSnapshot{
+				ConnectionId:      data.Options.ConnectionId,
+				ProjectId:         data.Options.ProjectId,
+				SnapshotDate:      time.Date(collectedAt.Year(), collectedAt.Month(), collectedAt.Day(), 0, 0, 0, 0, time.UTC),
+				DefinedPoints:     stats.DefinedPoints,
+				AssignedPoints:    stats.AssignedPoints,
+				ClosedPoints:      stats.ClosedPoints,
+				Speed:             stats.Speed,
+				TotalIssues:       issuesStats.TotalIssues,
+				OpenedIssues:      issuesStats.OpenedIssues,
+				ClosedIssues:      issuesStats.ClosedIssues,
+				IssuesPerStatus:   issuesStats.IssuesPerStatus.byName(),
+				IssuesPerSeverity: issuesStats.IssuesPerSeverity.byName(),
+				IssuesPerPriority: issuesStats.IssuesPerPriority.byName(),
+				IssuesPerType:     issuesStats.IssuesPerType.byName(),
+			}
+			if stats.TotalMilestones != nil {
+				snapshot.TotalMilestones = *stats.TotalMilestones
+			}
+			if stats.TotalPoints != nil {
+				snapshot.TotalPoints = *stats.TotalPoints
+			}
+
+			// raw rows are extracted oldest first, so the project ends up with the latest totals
+			updateErr := db.UpdateColumns(
+				&models.TaigaProject{},
+				[]dal.DalSet{
+					{ColumnName: "total_milestones", Value: snapshot.TotalMilestones},
+					{ColumnName: "total_story_points", Value: snapshot.TotalPoints},
+				},
+				dal.Where("connection_id = ? AND project_id = ?", data.Options.ConnectionId, data.Options.ProjectId),
+			)
+			if updateErr != nil {
+				return nil, updateErr
+			}
+
+			return []interface{}{snapshot}, nil
+		},
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return extractor.Execute()
+}
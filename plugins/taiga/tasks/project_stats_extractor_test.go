@@ -0,0 +1,53 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIssueCountsByName(t *testing.T) {
+	tests := []struct {
+		name string
+		raw  string
+		want map[string]int
+	}{
+		{"empty", `{}`, map[string]int{}},
+		{
+			"keyed by id",
+			`{"1": {"name": "New", "count": 4}, "2": {"name": "Closed", "count": 9}}`,
+			map[string]int{"New": 4, "Closed": 9},
+		},
+		{
+			"ids sharing a name are summed",
+			`{"1": {"name": "Bug", "count": 2}, "7": {"name": "Bug", "count": 3}, "8": {"name": "Question", "count": 0}}`,
+			map[string]int{"Bug": 5, "Question": 0},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var counts taigaApiIssueCounts
+			require.NoError(t, json.Unmarshal([]byte(tt.raw), &counts))
+			assert.Equal(t, tt.want, counts.byName())
+		})
+	}
+}
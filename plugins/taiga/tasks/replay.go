@@ -0,0 +1,36 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/plugin"
+)
+
+// skipOnReplay turns a collector into a no-op when the task replays raw data, the extractors and
+// convertors then work on the raw rows stored by earlier collections or imports
+func skipOnReplay(collect plugin.SubTaskEntryPoint) plugin.SubTaskEntryPoint {
+	return func(taskCtx plugin.SubTaskContext) errors.Error {
+		data := taskCtx.GetData().(*TaigaTaskData)
+		if data.Options.ReplayRawData {
+			taskCtx.GetLogger().Info("replaying raw data, collection skipped")
+			return nil
+		}
+		return collect(taskCtx)
+	}
+}
@@ -0,0 +1,39 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/plugin"
+	"github.com/irfanuddinahmad/taiga-devlake-plugin/plugins/taiga/models"
+)
+
+var _ plugin.SubTaskEntryPoint = ExtractTaskAttachments
+
+var ExtractTaskAttachmentsMeta = plugin.SubTaskMeta{
+	Name:             "extractTaskAttachments",
+	EntryPoint:       ExtractTaskAttachments,
+	EnabledByDefault: true,
+	Description:      "extract attachment metadata of Taiga tasks",
+	DomainTypes:      []string{models.ENTITY_TYPE_ATTACHMENT},
+	Dependencies:     []*plugin.SubTaskMeta{&CollectTaskAttachmentsMeta},
+}
+
+func ExtractTaskAttachments(taskCtx plugin.SubTaskContext) errors.Error {
+	return extractAttachments(taskCtx, models.ItemTypeTask, RAW_TASK_ATTACHMENT_TABLE)
+}
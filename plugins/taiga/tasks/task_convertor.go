@@ -0,0 +1,157 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"reflect"
+
+	"github.com/apache/incubator-devlake/core/dal"
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/models/domainlayer"
+	"github.com/apache/incubator-devlake/core/models/domainlayer/didgen"
+	"github.com/apache/incubator-devlake/core/models/domainlayer/ticket"
+	"github.com/apache/incubator-devlake/core/plugin"
+	"github.com/apache/incubator-devlake/helpers/pluginhelper/api"
+	"github.com/irfanuddinahmad/taiga-devlake-plugin/plugins/taiga/models"
+)
+
+var ConvertTasksMeta = plugin.SubTaskMeta{
+	Name:             "convertTasks",
+	EntryPoint:       ConvertTasks,
+	EnabledByDefault: true,
+	Description:      "convert Taiga tasks into sub-tasks of their user stories",
+	DomainTypes:      []string{plugin.DOMAIN_TYPE_TICKET},
+	// the task metrics come with the history and are only calculated when it is collected
+	Dependencies: []*plugin.SubTaskMeta{
+		&ExtractTasksMeta, &ExtractTaskStatusesMeta,
+		&ExtractCustomAttributesMeta, &ExtractTaskCustomAttributeValuesMeta,
+	},
+}
+
+// taskWithMetric is a task joined with its cycle time
+type taskWithMetric struct {
+	models.TaigaTask
+	CycleTimeMinutes *int64
+}
+
+func ConvertTasks(taskCtx plugin.SubTaskContext) errors.Error {
+	data := taskCtx.GetData().(*TaigaTaskData)
+	logger := taskCtx.GetLogger()
+	db := taskCtx.GetDal()
+	logger.Info("convert tasks of project:%d", data.Options.ProjectId)
+
+	taskIdGen := didgen.NewDomainIdGenerator(&models.TaigaTask{})
+	storyIdGen := didgen.NewDomainIdGenerator(&models.TaigaUserStory{})
+	accountIdGen := didgen.NewDomainIdGenerator(&models.TaigaAccount{})
+	boardId := didgen.NewDomainIdGenerator(&models.TaigaProject{}).Generate(data.Options.ConnectionId, data.Options.ProjectId)
+	statuses, err := newTaskStatusMapper(db, data)
+	if err != nil {
+		return err
+	}
+	customAttributeValues, err := loadCustomAttributeValues(db, data, models.ItemTypeTask)
+	if err != nil {
+		return err
+	}
+	issueType := data.Options.ScopeConfig.TypeMappings[models.ItemTypeTask].StandardType
+	if issueType == "" {
+		issueType = "SUBTASK"
+	}
+	labels, err := loadLabels(db, data, models.ItemTypeTask)
+	if err != nil {
+		return err
+	}
+	typeRules, err := newIssueTypeRules(data.Options.ScopeConfig)
+	if err != nil {
+		return err
+	}
+
+	// the metrics are missing when the history is not collected, the tasks are converted anyway
+	clauses := []dal.Clause{
+		dal.Select("t.*, m.cycle_time_minutes"),
+		dal.From("_tool_taiga_tasks t"),
+		dal.Join("LEFT JOIN _tool_taiga_task_metrics m ON m.connection_id = t.connection_id AND m.task_id = t.task_id"),
+		dal.Where("t.connection_id = ? AND t.project_id = ?", data.Options.ConnectionId, data.Options.ProjectId),
+	}
+	clauses = append(clauses, timeAfterClauses(data, "t.modified_date")...)
+	cursor, err := db.Cursor(clauses...)
+	if err != nil {
+		return err
+	}
+	defer cursor.Close()
+
+	converter, err := api.NewDataConverter(api.DataConverterArgs{
+		RawDataSubTaskArgs: api.RawDataSubTaskArgs{
+			Ctx: taskCtx,
+			Params: TaigaApiParams{
+				ConnectionId: data.Options.ConnectionId,
+				ProjectId:    data.Options.ProjectId,
+			},
+			Table: RAW_TASK_TABLE,
+		},
+		InputRowType: reflect.TypeOf(taskWithMetric{}),
+		Input:        cursor,
+		Convert: func(inputRow interface{}) ([]interface{}, errors.Error) {
+			task := inputRow.(*taskWithMetric)
+			issue := &ticket.Issue{
+				DomainEntity: domainlayer.DomainEntity{
+					Id: taskIdGen.Generate(task.ConnectionId, task.TaskId),
+				},
+				IssueKey:       task.Subject,
+				Title:          task.Subject,
+				Type:           issueType,
+				OriginalType:   "Task",
+				Status:         statuses.standardStatus(task.Status),
+				OriginalStatus: task.Status,
+				CreatedDate:    task.CreatedDate,
+				UpdatedDate:    task.ModifiedDate,
+				ResolutionDate: task.FinishedDate,
+			}
+			// tags name the type of a single task, ahead of the type of all tasks
+			if labelType := typeRules.match(labels[task.TaskId]); labelType != "" {
+				issue.Type = labelType
+			}
+			if task.UserStoryId != 0 {
+				issue.ParentIssueId = storyIdGen.Generate(task.ConnectionId, task.UserStoryId)
+			}
+			if task.AssignedTo != 0 {
+				issue.AssigneeId = accountIdGen.Generate(task.ConnectionId, task.AssignedTo)
+				issue.AssigneeName = task.AssignedToName
+			}
+			applyCustomAttributes(issue, customAttributeValues[task.TaskId], data.Options.ScopeConfig.CustomAttributeMappings)
+			if task.CycleTimeMinutes != nil {
+				leadTime := uint(*task.CycleTimeMinutes)
+				issue.LeadTimeMinutes = &leadTime
+			}
+
+			result := []interface{}{issue}
+			if worklog := spentTimeWorklog(issue, issue.AssigneeId, task.ModifiedDate, data.Options.ScopeConfig.CustomAttributeMappings); worklog != nil {
+				result = append(result, worklog)
+			}
+			result = append(result, &ticket.BoardIssue{
+				BoardId: boardId,
+				IssueId: issue.Id,
+			})
+			return result, nil
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	return converter.Execute()
+}
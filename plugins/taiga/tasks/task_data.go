@@ -19,6 +19,7 @@ package tasks
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/apache/incubator-devlake/core/errors"
 	"github.com/apache/incubator-devlake/helpers/pluginhelper/api"
@@ -31,11 +32,23 @@ type TaigaOptions struct {
 	ScopeConfig   *models.TaigaScopeConfig `json:"scopeConfig" mapstructure:"scopeConfig"`
 	ScopeConfigId uint64                   `json:"scopeConfigId" mapstructure:"scopeConfigId"`
 	PageSize      int                      `json:"pageSize" mapstructure:"pageSize"`
+	// ReplayRawData skips the api client and the collectors, the raw rows already stored are
+	// extracted and converted again, e.g. after a scope config change
+	ReplayRawData bool `json:"replayRawData" mapstructure:"replayRawData"`
+	// TimeAfter is an RFC 3339 time overriding the TimeAfter of the scope config
+	TimeAfter string `json:"timeAfter" mapstructure:"timeAfter"`
 }
 
 type TaigaTaskData struct {
-	Options   *TaigaOptions
+	Options *TaigaOptions
+	// ApiClient is nil when the task replays raw data
 	ApiClient *api.ApiAsyncClient
+	// Throttle is shared by the collectors of the task, nil when the task replays raw data
+	Throttle *TaigaThrottle
+	// TimeAfter is the start of the time window of the task, nil collects everything
+	TimeAfter *time.Time
+	// Connection gives the web links of the collected items
+	Connection *models.TaigaConnection
 }
 
 func DecodeAndValidateTaskOptions(options map[string]interface{}) (*TaigaOptions, errors.Error) {
@@ -0,0 +1,197 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"encoding/json"
+
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/models/common"
+	"github.com/apache/incubator-devlake/core/plugin"
+	"github.com/apache/incubator-devlake/helpers/pluginhelper/api"
+	"github.com/irfanuddinahmad/taiga-devlake-plugin/plugins/taiga/models"
+)
+
+var _ plugin.SubTaskEntryPoint = ExtractTasks
+
+var ExtractTasksMeta = plugin.SubTaskMeta{
+	Name:             "extractTasks",
+	EntryPoint:       ExtractTasks,
+	EnabledByDefault: true,
+	Description:      "extract Taiga tasks",
+	DomainTypes:      []string{plugin.DOMAIN_TYPE_TICKET},
+	Dependencies:     []*plugin.SubTaskMeta{&CollectTasksMeta},
+}
+
+var ExtractTaskStatusesMeta = plugin.SubTaskMeta{
+	Name:             "extractTaskStatuses",
+	EntryPoint:       ExtractTaskStatuses,
+	EnabledByDefault: true,
+	Description:      "extract the task statuses of the project",
+	DomainTypes:      []string{plugin.DOMAIN_TYPE_TICKET},
+	Dependencies:     []*plugin.SubTaskMeta{&CollectTaskStatusesMeta},
+}
+
+func ExtractTasks(taskCtx plugin.SubTaskContext) errors.Error {
+	data := taskCtx.GetData().(*TaigaTaskData)
+	extractor, err := api.NewApiExtractor(api.ApiExtractorArgs{
+		RawDataSubTaskArgs: api.RawDataSubTaskArgs{
+			Ctx: taskCtx,
+			Params: TaigaApiParams{
+				ConnectionId: data.Options.ConnectionId,
+				ProjectId:    data.Options.ProjectId,
+			},
+			Table: RAW_TASK_TABLE,
+		},
+		Extract: func(row *api.RawData) ([]interface{}, errors.Error) {
+			var apiTask struct {
+				Id              uint64  `json:"id"`
+				Ref             int     `json:"ref"`
+				Subject         string  `json:"subject"`
+				UserStory       *uint64 `json:"user_story"`
+				Milestone       *uint64 `json:"milestone"`
+				IsClosed        bool    `json:"is_closed"`
+				AssignedTo      *uint64 `json:"assigned_to"`
+				StatusExtraInfo struct {
+					Name string `json:"name"`
+				} `json:"status_extra_info"`
+				AssignedToExtraInfo *struct {
+					FullNameDisplay string `json:"full_name_display"`
+				} `json:"assigned_to_extra_info"`
+				CreatedDate  *common.Iso8601Time `json:"created_date"`
+				ModifiedDate *common.Iso8601Time `json:"modified_date"`
+				FinishedDate *common.Iso8601Time `json:"finished_date"`
+				Tags         [][]*string         `json:"tags"`
+				Watchers     []uint64            `json:"watchers"`
+				// the user stories the task was promoted to
+				GeneratedUserStories []uint64 `json:"generated_user_stories"`
+			}
+			err := json.Unmarshal(row.Data, &apiTask)
+			if err != nil {
+				return nil, errors.Default.Wrap(err, "error unmarshalling task")
+			}
+
+			task := &models.TaigaTask{
+				ConnectionId: data.Options.ConnectionId,
+				TaskId:       apiTask.Id,
+				ProjectId:    data.Options.ProjectId,
+				Ref:          apiTask.Ref,
+				Subject:      apiTask.Subject,
+				Status:       apiTask.StatusExtraInfo.Name,
+				IsClosed:     apiTask.IsClosed,
+				CreatedDate:  apiTask.CreatedDate.ToNullableTime(),
+				ModifiedDate: apiTask.ModifiedDate.ToNullableTime(),
+				FinishedDate: apiTask.FinishedDate.ToNullableTime(),
+			}
+			if apiTask.UserStory != nil {
+				task.UserStoryId = *apiTask.UserStory
+			}
+			if apiTask.Milestone != nil {
+				task.MilestoneId = *apiTask.Milestone
+			}
+			if apiTask.AssignedTo != nil {
+				task.AssignedTo = *apiTask.AssignedTo
+			}
+			if apiTask.AssignedToExtraInfo != nil {
+				task.AssignedToName = apiTask.AssignedToExtraInfo.FullNameDisplay
+			}
+
+			results := []interface{}{task}
+			for _, label := range extractLabels(apiTask.Tags, models.ItemTypeTask, apiTask.Id, data) {
+				results = append(results, label)
+			}
+			for _, watcher := range apiTask.Watchers {
+				results = append(results, &models.TaigaItemWatcher{
+					ConnectionId: data.Options.ConnectionId,
+					ItemType:     models.ItemTypeTask,
+					ItemId:       apiTask.Id,
+					AccountId:    watcher,
+					ProjectId:    data.Options.ProjectId,
+				})
+			}
+			if task.UserStoryId != 0 {
+				results = append(results, &models.TaigaItemRelationship{
+					ConnectionId:     data.Options.ConnectionId,
+					SourceItemType:   models.ItemTypeUserStory,
+					SourceItemId:     task.UserStoryId,
+					TargetItemType:   models.ItemTypeTask,
+					TargetItemId:     task.TaskId,
+					RelationshipType: models.RelationshipParent,
+					ProjectId:        data.Options.ProjectId,
+				})
+			}
+			for _, userStoryId := range apiTask.GeneratedUserStories {
+				results = append(results, generatedFrom(userStoryId, models.ItemTypeTask, task.TaskId, data))
+			}
+
+			return results, nil
+		},
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return extractor.Execute()
+}
+
+func ExtractTaskStatuses(taskCtx plugin.SubTaskContext) errors.Error {
+	data := taskCtx.GetData().(*TaigaTaskData)
+	extractor, err := api.NewApiExtractor(api.ApiExtractorArgs{
+		RawDataSubTaskArgs: api.RawDataSubTaskArgs{
+			Ctx: taskCtx,
+			Params: TaigaApiParams{
+				ConnectionId: data.Options.ConnectionId,
+				ProjectId:    data.Options.ProjectId,
+			},
+			Table: RAW_TASK_STATUS_TABLE,
+		},
+		Extract: func(row *api.RawData) ([]interface{}, errors.Error) {
+			var apiStatus struct {
+				Id       uint64 `json:"id"`
+				Name     string `json:"name"`
+				Slug     string `json:"slug"`
+				IsClosed bool   `json:"is_closed"`
+				Order    int    `json:"order"`
+			}
+			err := json.Unmarshal(row.Data, &apiStatus)
+			if err != nil {
+				return nil, errors.Default.Wrap(err, "error unmarshalling task status")
+			}
+
+			status := &models.TaigaStatus{
+				ConnectionId: data.Options.ConnectionId,
+				ItemType:     models.ItemTypeTask,
+				StatusId:     apiStatus.Id,
+				ProjectId:    data.Options.ProjectId,
+				Name:         apiStatus.Name,
+				Slug:         apiStatus.Slug,
+				IsClosed:     apiStatus.IsClosed,
+				Order:        apiStatus.Order,
+			}
+
+			return []interface{}{status}, nil
+		},
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return extractor.Execute()
+}
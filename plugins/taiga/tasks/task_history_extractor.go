@@ -0,0 +1,83 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"encoding/json"
+
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/plugin"
+	"github.com/apache/incubator-devlake/helpers/pluginhelper/api"
+	"github.com/irfanuddinahmad/taiga-devlake-plugin/plugins/taiga/models"
+)
+
+var _ plugin.SubTaskEntryPoint = ExtractTaskHistories
+
+var ExtractTaskHistoriesMeta = plugin.SubTaskMeta{
+	Name:             "extractTaskHistories",
+	EntryPoint:       ExtractTaskHistories,
+	EnabledByDefault: true,
+	Description:      "extract the field changes and comments of Taiga tasks from their history",
+	DomainTypes:      []string{models.ENTITY_TYPE_HISTORY, models.ENTITY_TYPE_COMMENT},
+	Dependencies:     []*plugin.SubTaskMeta{&CollectTaskHistoriesMeta},
+}
+
+func ExtractTaskHistories(taskCtx plugin.SubTaskContext) errors.Error {
+	data := taskCtx.GetData().(*TaigaTaskData)
+	extractor, err := api.NewApiExtractor(api.ApiExtractorArgs{
+		RawDataSubTaskArgs: api.RawDataSubTaskArgs{
+			Ctx: taskCtx,
+			Params: TaigaApiParams{
+				ConnectionId: data.Options.ConnectionId,
+				ProjectId:    data.Options.ProjectId,
+			},
+			Table: RAW_TASK_HISTORY_TABLE,
+		},
+		Extract: func(row *api.RawData) ([]interface{}, errors.Error) {
+			var input SimpleTask
+			err := json.Unmarshal(row.Input, &input)
+			if err != nil {
+				return nil, errors.Default.Wrap(err, "error unmarshalling task history input")
+			}
+			var entry TaigaApiHistoryEntry
+			err = json.Unmarshal(row.Data, &entry)
+			if err != nil {
+				return nil, errors.Default.Wrap(err, "error unmarshalling task history")
+			}
+			if beforeTimeAfter(data, entry.CreatedAt.ToNullableTime()) {
+				return nil, nil
+			}
+
+			var results []interface{}
+			if comment := extractComment(&entry, models.ItemTypeTask, input.TaskId, data); comment != nil {
+				results = append(results, comment)
+			}
+			for _, changelog := range extractChangelogs(&entry, models.ItemTypeTask, input.TaskId, data) {
+				results = append(results, changelog)
+			}
+
+			return results, nil
+		},
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return extractor.Execute()
+}
@@ -0,0 +1,181 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"time"
+
+	"github.com/apache/incubator-devlake/core/dal"
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/models/domainlayer/ticket"
+	"github.com/apache/incubator-devlake/core/plugin"
+	"github.com/irfanuddinahmad/taiga-devlake-plugin/plugins/taiga/models"
+)
+
+var _ plugin.SubTaskEntryPoint = CalculateTaskMetrics
+
+var CalculateTaskMetricsMeta = plugin.SubTaskMeta{
+	Name:             "calculateTaskMetrics",
+	EntryPoint:       CalculateTaskMetrics,
+	EnabledByDefault: true,
+	Description:      "calculate when Taiga tasks went in progress and were closed from the status changes in their history",
+	DomainTypes:      []string{models.ENTITY_TYPE_HISTORY},
+	Dependencies:     []*plugin.SubTaskMeta{&ExtractTasksMeta, &ExtractTaskStatusesMeta, &ExtractTaskHistoriesMeta},
+}
+
+const fieldStatus = "status"
+
+// CalculateTaskMetrics rebuilds the task metrics of a project, the statuses are turned into
+// standard ones with the status mappings of the scope config or else the status catalog
+func CalculateTaskMetrics(taskCtx plugin.SubTaskContext) errors.Error {
+	data := taskCtx.GetData().(*TaigaTaskData)
+	logger := taskCtx.GetLogger()
+	db := taskCtx.GetDal()
+	logger.Info("calculate task metrics of project:%d", data.Options.ProjectId)
+
+	statuses, err := newTaskStatusMapper(db, data)
+	if err != nil {
+		return err
+	}
+	var taigaTasks []models.TaigaTask
+	err = db.All(
+		&taigaTasks,
+		dal.Where("connection_id = ? AND project_id = ?", data.Options.ConnectionId, data.Options.ProjectId),
+	)
+	if err != nil {
+		return err
+	}
+	var changelogs []models.TaigaIssueChangelog
+	err = db.All(
+		&changelogs,
+		dal.Where("connection_id = ? AND project_id = ? AND item_type = ? AND field_name = ?",
+			data.Options.ConnectionId, data.Options.ProjectId, models.ItemTypeTask, fieldStatus),
+		dal.Orderby("item_id, created_date, changelog_id"),
+	)
+	if err != nil {
+		return err
+	}
+	changelogsByTask := make(map[uint64][]models.TaigaIssueChangelog)
+	for _, changelog := range changelogs {
+		changelogsByTask[changelog.ItemId] = append(changelogsByTask[changelog.ItemId], changelog)
+	}
+
+	err = db.Delete(
+		&models.TaigaTaskMetric{},
+		dal.Where("connection_id = ? AND project_id = ?", data.Options.ConnectionId, data.Options.ProjectId),
+	)
+	if err != nil {
+		return err
+	}
+	for _, task := range taigaTasks {
+		metric := taskMetric(&task, changelogsByTask[task.TaskId], statuses)
+		if err = db.CreateOrUpdate(metric); err != nil {
+			return err
+		}
+	}
+	logger.Info("calculated metrics of %d tasks", len(taigaTasks))
+	return nil
+}
+
+// taskMetric walks the status changes of one task in chronological order, the cycle starts with
+// the first move to an in progress status and ends with the last move to a done status, moving
+// the task out of done again reopens it
+func taskMetric(task *models.TaigaTask, changelogs []models.TaigaIssueChangelog, statuses *taskStatusMapper) *models.TaigaTaskMetric {
+	metric := &models.TaigaTaskMetric{
+		ConnectionId: task.ConnectionId,
+		TaskId:       task.TaskId,
+		ProjectId:    task.ProjectId,
+		AssignedTo:   task.AssignedTo,
+	}
+	for _, changelog := range changelogs {
+		if changelog.CreatedDate == nil {
+			continue
+		}
+		switch statuses.standardStatus(changelog.ToValue) {
+		case ticket.IN_PROGRESS:
+			if metric.InProgressDate == nil {
+				metric.InProgressDate = changelog.CreatedDate
+			}
+			metric.ClosedDate = nil
+		case ticket.DONE:
+			metric.ClosedDate = changelog.CreatedDate
+		default:
+			metric.ClosedDate = nil
+		}
+	}
+	if !task.IsClosed {
+		metric.ClosedDate = nil
+	} else if metric.ClosedDate == nil {
+		// the history may have been trimmed, Taiga records when the task was closed
+		metric.ClosedDate = task.FinishedDate
+	}
+	if metric.InProgressDate != nil && metric.ClosedDate != nil && !metric.ClosedDate.Before(*metric.InProgressDate) {
+		minutes := int64(metric.ClosedDate.Sub(*metric.InProgressDate) / time.Minute)
+		metric.CycleTimeMinutes = &minutes
+	}
+	return metric
+}
+
+// taskStatusMapper turns the names of task statuses into standard statuses
+type taskStatusMapper struct {
+	mappings models.StatusMappings
+	catalog  map[string]models.TaigaStatus
+	// order of the first status of the workflow, where new tasks start
+	firstOrder int
+}
+
+func newTaskStatusMapper(db dal.Dal, data *TaigaTaskData) (*taskStatusMapper, errors.Error) {
+	var statuses []models.TaigaStatus
+	err := db.All(
+		&statuses,
+		dal.Where("connection_id = ? AND project_id = ? AND item_type = ?",
+			data.Options.ConnectionId, data.Options.ProjectId, models.ItemTypeTask),
+	)
+	if err != nil {
+		return nil, err
+	}
+	mapper := &taskStatusMapper{
+		mappings: data.Options.ScopeConfig.TypeMappings[models.ItemTypeTask].StatusMappings,
+		catalog:  make(map[string]models.TaigaStatus, len(statuses)),
+	}
+	for i, status := range statuses {
+		if i == 0 || status.Order < mapper.firstOrder {
+			mapper.firstOrder = status.Order
+		}
+		mapper.catalog[status.Name] = status
+	}
+	return mapper, nil
+}
+
+// standardStatus prefers the status mappings of the scope config, closed statuses of the catalog
+// are done and every open status after the first one is in progress
+func (m *taskStatusMapper) standardStatus(name string) string {
+	if mapping, ok := m.mappings[name]; ok && mapping.StandardStatus != "" {
+		return mapping.StandardStatus
+	}
+	status, ok := m.catalog[name]
+	switch {
+	case !ok:
+		return ticket.OTHER
+	case status.IsClosed:
+		return ticket.DONE
+	case status.Order > m.firstOrder:
+		return ticket.IN_PROGRESS
+	}
+	return ticket.TODO
+}
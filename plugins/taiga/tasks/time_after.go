@@ -0,0 +1,46 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"net/url"
+	"time"
+
+	"github.com/apache/incubator-devlake/core/dal"
+)
+
+// setTimeAfterQuery restricts a Taiga list to items modified since the TimeAfter of the task
+func setTimeAfterQuery(query url.Values, data *TaigaTaskData) {
+	if data.TimeAfter != nil {
+		query.Set("modified_date__gte", data.TimeAfter.UTC().Format(time.RFC3339))
+	}
+}
+
+// timeAfterClauses returns the clauses leaving out tool rows whose column is before the TimeAfter
+// of the task
+func timeAfterClauses(data *TaigaTaskData, column string) []dal.Clause {
+	if data.TimeAfter == nil {
+		return nil
+	}
+	return []dal.Clause{dal.Where(column+" >= ?", data.TimeAfter)}
+}
+
+// beforeTimeAfter reports whether a date falls before the TimeAfter of the task
+func beforeTimeAfter(data *TaigaTaskData, date *time.Time) bool {
+	return data.TimeAfter != nil && date != nil && date.Before(*data.TimeAfter)
+}
@@ -0,0 +1,73 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetTimeAfterQuery(t *testing.T) {
+	tests := []struct {
+		name      string
+		timeAfter *time.Time
+		want      string
+	}{
+		{"no time window", nil, ""},
+		{"utc", timePtr("2024-01-01T00:00:00Z"), "2024-01-01T00:00:00Z"},
+		{"other zone is sent in utc", timePtr("2024-01-01T02:30:00+02:00"), "2024-01-01T00:30:00Z"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query := url.Values{}
+			setTimeAfterQuery(query, &TaigaTaskData{TimeAfter: tt.timeAfter})
+			assert.Equal(t, tt.want, query.Get("modified_date__gte"))
+			assert.Equal(t, tt.timeAfter != nil, query.Has("modified_date__gte"))
+		})
+	}
+}
+
+func TestTimeAfterClauses(t *testing.T) {
+	assert.Empty(t, timeAfterClauses(&TaigaTaskData{}, "created_date"))
+	assert.Len(t, timeAfterClauses(&TaigaTaskData{TimeAfter: timePtr("2024-01-01T00:00:00Z")}, "created_date"), 1)
+}
+
+func TestBeforeTimeAfter(t *testing.T) {
+	timeAfter := timePtr("2024-01-01T00:00:00Z")
+	tests := []struct {
+		name      string
+		timeAfter *time.Time
+		date      *time.Time
+		want      bool
+	}{
+		{"no time window", nil, timePtr("2020-01-01T00:00:00Z"), false},
+		{"no date", timeAfter, nil, false},
+		{"before", timeAfter, timePtr("2023-12-31T23:59:59Z"), true},
+		{"at the start is kept", timeAfter, timePtr("2024-01-01T00:00:00Z"), false},
+		{"after", timeAfter, timePtr("2024-01-02T00:00:00Z"), false},
+		{"same instant in another zone", timeAfter, timePtr("2024-01-01T01:00:00+01:00"), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, beforeTimeAfter(&TaigaTaskData{TimeAfter: tt.timeAfter}, tt.date))
+		})
+	}
+}
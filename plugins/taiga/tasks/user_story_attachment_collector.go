@@ -0,0 +1,100 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+
+	"github.com/apache/incubator-devlake/core/dal"
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/plugin"
+	"github.com/apache/incubator-devlake/helpers/pluginhelper/api"
+	"github.com/irfanuddinahmad/taiga-devlake-plugin/plugins/taiga/models"
+)
+
+const RAW_USER_STORY_ATTACHMENT_TABLE = "taiga_api_user_story_attachments"
+
+var _ plugin.SubTaskEntryPoint = CollectUserStoryAttachments
+
+var CollectUserStoryAttachmentsMeta = plugin.SubTaskMeta{
+	Name:             "collectUserStoryAttachments",
+	EntryPoint:       skipOnReplay(CollectUserStoryAttachments),
+	EnabledByDefault: true,
+	Description:      "collect attachment metadata of Taiga user stories",
+	DomainTypes:      []string{models.ENTITY_TYPE_ATTACHMENT},
+	Dependencies:     []*plugin.SubTaskMeta{&ExtractUserStoriesMeta},
+}
+
+func CollectUserStoryAttachments(taskCtx plugin.SubTaskContext) errors.Error {
+	data := taskCtx.GetData().(*TaigaTaskData)
+	logger := taskCtx.GetLogger()
+	db := taskCtx.GetDal()
+	logger.Info("collect user story attachments")
+
+	cursor, err := db.Cursor(
+		dal.Select("user_story_id"),
+		dal.From(&models.TaigaUserStory{}),
+		dal.Where("connection_id = ? AND project_id = ?", data.Options.ConnectionId, data.Options.ProjectId),
+	)
+	if err != nil {
+		return err
+	}
+	iterator, err := api.NewDalCursorIterator(db, cursor, reflect.TypeOf(SimpleUserStory{}))
+	if err != nil {
+		return err
+	}
+
+	collector, err := api.NewApiCollector(api.ApiCollectorArgs{
+		RawDataSubTaskArgs: api.RawDataSubTaskArgs{
+			Ctx: taskCtx,
+			Params: TaigaApiParams{
+				ConnectionId: data.Options.ConnectionId,
+				ProjectId:    data.Options.ProjectId,
+			},
+			Table: RAW_USER_STORY_ATTACHMENT_TABLE,
+		},
+		ApiClient:   data.ApiClient,
+		Input:       iterator,
+		UrlTemplate: "userstories/attachments",
+		Query: func(reqData *api.RequestData) (url.Values, errors.Error) {
+			input := reqData.Input.(*SimpleUserStory)
+			query := url.Values{}
+			query.Set("project", fmt.Sprintf("%d", data.Options.ProjectId))
+			query.Set("object_id", fmt.Sprintf("%d", input.UserStoryId))
+			return query, nil
+		},
+		ResponseParser: func(res *http.Response) ([]json.RawMessage, errors.Error) {
+			var result []json.RawMessage
+			err := api.UnmarshalResponse(res, &result)
+			if err != nil {
+				return nil, err
+			}
+			return result, nil
+		},
+		AfterResponse: withThrottling(data, ignoreHTTPStatus404),
+	})
+	if err != nil {
+		logger.Error(err, "collect user story attachments error")
+		return err
+	}
+	return collector.Execute()
+}
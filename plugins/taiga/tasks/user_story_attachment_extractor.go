@@ -0,0 +1,99 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"encoding/json"
+
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/models/common"
+	"github.com/apache/incubator-devlake/core/plugin"
+	"github.com/apache/incubator-devlake/helpers/pluginhelper/api"
+	"github.com/irfanuddinahmad/taiga-devlake-plugin/plugins/taiga/models"
+)
+
+var _ plugin.SubTaskEntryPoint = ExtractUserStoryAttachments
+
+var ExtractUserStoryAttachmentsMeta = plugin.SubTaskMeta{
+	Name:             "extractUserStoryAttachments",
+	EntryPoint:       ExtractUserStoryAttachments,
+	EnabledByDefault: true,
+	Description:      "extract attachment metadata of Taiga user stories",
+	DomainTypes:      []string{models.ENTITY_TYPE_ATTACHMENT},
+	Dependencies:     []*plugin.SubTaskMeta{&CollectUserStoryAttachmentsMeta},
+}
+
+func ExtractUserStoryAttachments(taskCtx plugin.SubTaskContext) errors.Error {
+	return extractAttachments(taskCtx, models.ItemTypeUserStory, RAW_USER_STORY_ATTACHMENT_TABLE)
+}
+
+// extractAttachments extracts the attachments of one item type, Taiga numbers attachments across
+// item types so their ids don't collide
+func extractAttachments(taskCtx plugin.SubTaskContext, itemType string, table string) errors.Error {
+	data := taskCtx.GetData().(*TaigaTaskData)
+	extractor, err := api.NewApiExtractor(api.ApiExtractorArgs{
+		RawDataSubTaskArgs: api.RawDataSubTaskArgs{
+			Ctx: taskCtx,
+			Params: TaigaApiParams{
+				ConnectionId: data.Options.ConnectionId,
+				ProjectId:    data.Options.ProjectId,
+			},
+			Table: table,
+		},
+		Extract: func(row *api.RawData) ([]interface{}, errors.Error) {
+			var apiAttachment struct {
+				Id           uint64              `json:"id"`
+				ObjectId     uint64              `json:"object_id"`
+				Name         string              `json:"name"`
+				Description  string              `json:"description"`
+				Size         int64               `json:"size"`
+				Url          string              `json:"url"`
+				Owner        uint64              `json:"owner"`
+				CreatedDate  *common.Iso8601Time `json:"created_date"`
+				IsDeprecated bool                `json:"is_deprecated"`
+			}
+			err := json.Unmarshal(row.Data, &apiAttachment)
+			if err != nil {
+				return nil, errors.Default.Wrap(err, "error unmarshalling attachment")
+			}
+
+			attachment := &models.TaigaAttachment{
+				ConnectionId: data.Options.ConnectionId,
+				AttachmentId: apiAttachment.Id,
+				ItemType:     itemType,
+				ItemId:       apiAttachment.ObjectId,
+				ProjectId:    data.Options.ProjectId,
+				Name:         apiAttachment.Name,
+				Description:  apiAttachment.Description,
+				Size:         apiAttachment.Size,
+				Url:          apiAttachment.Url,
+				OwnerId:      apiAttachment.Owner,
+				CreatedDate:  apiAttachment.CreatedDate.ToNullableTime(),
+				IsDeprecated: apiAttachment.IsDeprecated,
+			}
+
+			return []interface{}{attachment}, nil
+		},
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return extractor.Execute()
+}
@@ -34,7 +34,7 @@ var _ plugin.SubTaskEntryPoint = CollectUserStories
 
 var CollectUserStoriesMeta = plugin.SubTaskMeta{
 	Name:             "collectUserStories",
-	EntryPoint:       CollectUserStories,
+	EntryPoint:       skipOnReplay(CollectUserStories),
 	EnabledByDefault: true,
 	Description:      "collect Taiga user stories",
 	DomainTypes:      []string{plugin.DOMAIN_TYPE_TICKET},
@@ -44,7 +44,7 @@ func CollectUserStories(taskCtx plugin.SubTaskContext) errors.Error {
 	data := taskCtx.GetData().(*TaigaTaskData)
 	logger := taskCtx.GetLogger()
 	logger.Info("collect user stories")
-	
+
 	collector, err := api.NewApiCollector(api.ApiCollectorArgs{
 		RawDataSubTaskArgs: api.RawDataSubTaskArgs{
 			Ctx: taskCtx,
@@ -54,12 +54,14 @@ func CollectUserStories(taskCtx plugin.SubTaskContext) errors.Error {
 			},
 			Table: RAW_USER_STORY_TABLE,
 		},
-		ApiClient:   data.ApiClient,
-		PageSize:    1000, // Fetch all in one page - Taiga returns all user stories for a project
-		UrlTemplate: "api/v1/userstories",
+		ApiClient:     data.ApiClient,
+		AfterResponse: withThrottling(data, nil),
+		PageSize:      1000, // Fetch all in one page - Taiga returns all user stories for a project
+		UrlTemplate:   "userstories",
 		Query: func(reqData *api.RequestData) (url.Values, errors.Error) {
 			query := url.Values{}
 			query.Set("project", fmt.Sprintf("%d", data.Options.ProjectId))
+			setTimeAfterQuery(query, data)
 			// Don't specify page - get all results
 			return query, nil
 		},
@@ -34,6 +34,7 @@ var ConvertUserStoriesMeta = plugin.SubTaskMeta{
 	EnabledByDefault: true,
 	Description:      "convert Taiga user stories",
 	DomainTypes:      []string{plugin.DOMAIN_TYPE_TICKET},
+	Dependencies:     []*plugin.SubTaskMeta{&ExtractUserStoriesMeta, &ExtractCustomAttributesMeta, &ExtractCustomAttributeValuesMeta},
 }
 
 func ConvertUserStories(subtaskCtx plugin.SubTaskContext) errors.Error {
@@ -44,6 +45,23 @@ func ConvertUserStories(subtaskCtx plugin.SubTaskContext) errors.Error {
 	issueIdGen := didgen.NewDomainIdGenerator(&models.TaigaUserStory{})
 	boardIdGen := didgen.NewDomainIdGenerator(&models.TaigaProject{})
 	boardId := boardIdGen.Generate(data.Options.ConnectionId, data.Options.ProjectId)
+	accountIdGen := didgen.NewDomainIdGenerator(&models.TaigaAccount{})
+	customAttributeValues, err := loadCustomAttributeValues(db, data, models.ItemTypeUserStory)
+	if err != nil {
+		return err
+	}
+	rolePoints, err := loadRoleStoryPoints(db, data)
+	if err != nil {
+		return err
+	}
+	labels, err := loadLabels(db, data, models.ItemTypeUserStory)
+	if err != nil {
+		return err
+	}
+	typeRules, err := newIssueTypeRules(data.Options.ScopeConfig)
+	if err != nil {
+		return err
+	}
 
 	converter, err := api.NewStatefulDataConverter(&api.StatefulDataConverterArgs[models.TaigaUserStory]{
 		SubtaskCommonArgs: &api.SubtaskCommonArgs{
@@ -58,8 +76,9 @@ func ConvertUserStories(subtaskCtx plugin.SubTaskContext) errors.Error {
 			clauses := []dal.Clause{
 				dal.Select("*"),
 				dal.From(&models.TaigaUserStory{}),
-				dal.Where("connection_id = ?", data.Options.ConnectionId),
+				dal.Where("connection_id = ? AND project_id = ?", data.Options.ConnectionId, data.Options.ProjectId),
 			}
+			clauses = append(clauses, timeAfterClauses(data, "modified_date")...)
 			if stateManager.IsIncremental() {
 				since := stateManager.GetSince()
 				if since != nil {
@@ -70,7 +89,7 @@ func ConvertUserStories(subtaskCtx plugin.SubTaskContext) errors.Error {
 		},
 		Convert: func(userStory *models.TaigaUserStory) ([]interface{}, errors.Error) {
 			var result []interface{}
-			
+
 			issue := &ticket.Issue{
 				DomainEntity: domainlayer.DomainEntity{
 					Id: issueIdGen.Generate(userStory.ConnectionId, userStory.UserStoryId),
@@ -81,20 +100,39 @@ func ConvertUserStories(subtaskCtx plugin.SubTaskContext) errors.Error {
 				OriginalType:   "User Story",
 				Status:         userStory.Status,
 				OriginalStatus: userStory.Status,
+				CreatedDate:    userStory.CreatedDate,
+				UpdatedDate:    userStory.ModifiedDate,
+				ResolutionDate: userStory.FinishedDate,
+				DueDate:        userStory.DueDate,
 			}
-			
-			if userStory.TotalPoints > 0 {
+
+			if issueType := typeRules.match(labels[userStory.UserStoryId]); issueType != "" {
+				issue.Type = issueType
+			}
+			if rolePoints != nil {
+				issue.StoryPoint = rolePoints[userStory.UserStoryId]
+			} else if userStory.TotalPoints > 0 {
 				issue.StoryPoint = &userStory.TotalPoints
 			}
-			
+			var assigneeId string
+			if userStory.AssignedTo != 0 {
+				assigneeId = accountIdGen.Generate(userStory.ConnectionId, userStory.AssignedTo)
+				issue.AssigneeId = assigneeId
+				issue.AssigneeName = userStory.AssignedToName
+			}
+			applyCustomAttributes(issue, customAttributeValues[userStory.UserStoryId], data.Options.ScopeConfig.CustomAttributeMappings)
+
 			result = append(result, issue)
-			
+			if worklog := spentTimeWorklog(issue, assigneeId, userStory.ModifiedDate, data.Options.ScopeConfig.CustomAttributeMappings); worklog != nil {
+				result = append(result, worklog)
+			}
+
 			boardIssue := &ticket.BoardIssue{
 				BoardId: boardId,
 				IssueId: issue.Id,
 			}
 			result = append(result, boardIssue)
-			
+
 			logger.Debug("converted user story %d", userStory.UserStoryId)
 			return result, nil
 		},
@@ -106,3 +144,33 @@ func ConvertUserStories(subtaskCtx plugin.SubTaskContext) errors.Error {
 
 	return converter.Execute()
 }
+
+// loadRoleStoryPoints resolves the estimates of the role configured as StoryPointRole, keyed by
+// user story id, it returns nil when the total points should be used
+func loadRoleStoryPoints(db dal.Dal, data *TaigaTaskData) (map[uint64]*float64, errors.Error) {
+	roleName := data.Options.ScopeConfig.StoryPointRole
+	if roleName == "" {
+		return nil, nil
+	}
+	var rows []struct {
+		UserStoryId uint64
+		Value       *float64
+	}
+	err := db.All(
+		&rows,
+		dal.Select("rp.user_story_id, p.value"),
+		dal.From("_tool_taiga_user_story_role_points rp"),
+		dal.Join("LEFT JOIN _tool_taiga_roles r ON r.connection_id = rp.connection_id AND r.role_id = rp.role_id"),
+		dal.Join("LEFT JOIN _tool_taiga_points p ON p.connection_id = rp.connection_id AND p.point_id = rp.point_id"),
+		dal.Where("rp.connection_id = ? AND rp.project_id = ? AND LOWER(r.name) = LOWER(?)",
+			data.Options.ConnectionId, data.Options.ProjectId, roleName),
+	)
+	if err != nil {
+		return nil, err
+	}
+	points := make(map[uint64]*float64, len(rows))
+	for _, row := range rows {
+		points[row.UserStoryId] = row.Value
+	}
+	return points, nil
+}
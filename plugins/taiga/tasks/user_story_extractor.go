@@ -19,8 +19,11 @@ package tasks
 
 import (
 	"encoding/json"
+	"strconv"
+	"time"
 
 	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/models/common"
 	"github.com/apache/incubator-devlake/core/plugin"
 	"github.com/apache/incubator-devlake/helpers/pluginhelper/api"
 	"github.com/irfanuddinahmad/taiga-devlake-plugin/plugins/taiga/models"
@@ -34,6 +37,7 @@ var ExtractUserStoriesMeta = plugin.SubTaskMeta{
 	EnabledByDefault: true,
 	Description:      "extract Taiga user stories",
 	DomainTypes:      []string{plugin.DOMAIN_TYPE_TICKET},
+	Dependencies:     []*plugin.SubTaskMeta{&CollectUserStoriesMeta},
 }
 
 func ExtractUserStories(taskCtx plugin.SubTaskContext) errors.Error {
@@ -49,27 +53,41 @@ func ExtractUserStories(taskCtx plugin.SubTaskContext) errors.Error {
 		},
 		Extract: func(row *api.RawData) ([]interface{}, errors.Error) {
 			var apiUserStory struct {
-				Id              uint64  `json:"id"`
-				Ref             int     `json:"ref"`
-				Subject         string  `json:"subject"`
-				Status          uint64  `json:"status"`
+				Id              uint64 `json:"id"`
+				Ref             int    `json:"ref"`
+				Subject         string `json:"subject"`
+				Status          uint64 `json:"status"`
 				StatusExtraInfo struct {
 					Name string `json:"name"`
 				} `json:"status_extra_info"`
-				CreatedDate    string  `json:"created_date"`
-				ModifiedDate   string  `json:"modified_date"`
-				FinishDate     *string `json:"finish_date"`
-				AssignedTo     *uint64 `json:"assigned_to"`
-				TotalPoints    *float64 `json:"total_points"`
-				MilestoneId    *uint64 `json:"milestone"`
-				Priority       *int    `json:"priority"`
-				IsBlocked      bool    `json:"is_blocked"`
+				CreatedDate   *common.Iso8601Time `json:"created_date"`
+				ModifiedDate  *common.Iso8601Time `json:"modified_date"`
+				FinishDate    *common.Iso8601Time `json:"finish_date"`
+				IsClosed      bool                `json:"is_closed"`
+				DueDate       *string             `json:"due_date"`
+				DueDateReason string              `json:"due_date_reason"`
+				AssignedTo    *uint64             `json:"assigned_to"`
+				TotalPoints   *float64            `json:"total_points"`
+				MilestoneId   *uint64             `json:"milestone"`
+				Priority      *int                `json:"priority"`
+				IsBlocked     bool                `json:"is_blocked"`
+				BlockedNote   string              `json:"blocked_note"`
+				// set when the story was promoted from an issue or a task
+				GeneratedFromIssue *uint64 `json:"generated_from_issue"`
+				GeneratedFromTask  *uint64 `json:"generated_from_task"`
+				// role id to point id
+				Points map[string]uint64 `json:"points"`
+				// pairs of name and color, the color is null when none was picked
+				Tags          [][]*string `json:"tags"`
+				Watchers      []uint64    `json:"watchers"`
+				TotalWatchers *int        `json:"total_watchers"`
+				TotalVoters   *int        `json:"total_voters"`
 			}
 			err := json.Unmarshal(row.Data, &apiUserStory)
 			if err != nil {
 				return nil, errors.Default.Wrap(err, "error unmarshalling user story")
 			}
-			
+
 			var assignedTo uint64
 			if apiUserStory.AssignedTo != nil {
 				assignedTo = *apiUserStory.AssignedTo
@@ -89,6 +107,7 @@ func ExtractUserStories(taskCtx plugin.SubTaskContext) errors.Error {
 
 			userStory := &models.TaigaUserStory{
 				ConnectionId:  data.Options.ConnectionId,
+				ProjectId:     data.Options.ProjectId,
 				UserStoryId:   apiUserStory.Id,
 				Ref:           apiUserStory.Ref,
 				Subject:       apiUserStory.Subject,
@@ -98,15 +117,94 @@ func ExtractUserStories(taskCtx plugin.SubTaskContext) errors.Error {
 				MilestoneId:   milestoneId,
 				Priority:      priority,
 				IsBlocked:     apiUserStory.IsBlocked,
+				BlockedNote:   apiUserStory.BlockedNote,
+				IsClosed:      apiUserStory.IsClosed,
+				CreatedDate:   apiUserStory.CreatedDate.ToNullableTime(),
+				ModifiedDate:  apiUserStory.ModifiedDate.ToNullableTime(),
+				FinishedDate:  apiUserStory.FinishDate.ToNullableTime(),
+				DueDateReason: apiUserStory.DueDateReason,
+				// older Taiga versions don't send total_watchers
+				TotalWatchers: len(apiUserStory.Watchers),
+			}
+			if apiUserStory.TotalWatchers != nil {
+				userStory.TotalWatchers = *apiUserStory.TotalWatchers
+			}
+			if apiUserStory.TotalVoters != nil {
+				userStory.TotalVoters = *apiUserStory.TotalVoters
+			}
+			if apiUserStory.DueDate != nil {
+				dueDate, dateErr := parseTaigaDate(*apiUserStory.DueDate)
+				if dateErr != nil {
+					return nil, dateErr
+				}
+				userStory.DueDate = dueDate
+			}
+
+			results := []interface{}{userStory}
+			for roleId, pointId := range apiUserStory.Points {
+				role, convErr := strconv.ParseUint(roleId, 10, 64)
+				if convErr != nil {
+					return nil, errors.Default.Wrap(convErr, "invalid role id in user story points")
+				}
+				results = append(results, &models.TaigaUserStoryRolePoint{
+					ConnectionId: data.Options.ConnectionId,
+					UserStoryId:  apiUserStory.Id,
+					RoleId:       role,
+					ProjectId:    data.Options.ProjectId,
+					PointId:      pointId,
+				})
+			}
+			for _, label := range extractLabels(apiUserStory.Tags, models.ItemTypeUserStory, apiUserStory.Id, data) {
+				results = append(results, label)
+			}
+			if apiUserStory.GeneratedFromIssue != nil {
+				results = append(results, generatedFrom(apiUserStory.Id, models.ItemTypeIssue, *apiUserStory.GeneratedFromIssue, data))
+			}
+			if apiUserStory.GeneratedFromTask != nil {
+				results = append(results, generatedFrom(apiUserStory.Id, models.ItemTypeTask, *apiUserStory.GeneratedFromTask, data))
+			}
+			for _, watcher := range apiUserStory.Watchers {
+				results = append(results, &models.TaigaItemWatcher{
+					ConnectionId: data.Options.ConnectionId,
+					ItemType:     models.ItemTypeUserStory,
+					ItemId:       apiUserStory.Id,
+					AccountId:    watcher,
+					ProjectId:    data.Options.ProjectId,
+				})
 			}
-			
-			return []interface{}{userStory}, nil
+
+			return results, nil
 		},
 	})
-	
+
 	if err != nil {
 		return err
 	}
 
 	return extractor.Execute()
 }
+
+// generatedFrom links a user story to the item it was promoted from
+func generatedFrom(userStoryId uint64, itemType string, itemId uint64, data *TaigaTaskData) *models.TaigaItemRelationship {
+	return &models.TaigaItemRelationship{
+		ConnectionId:     data.Options.ConnectionId,
+		SourceItemType:   models.ItemTypeUserStory,
+		SourceItemId:     userStoryId,
+		TargetItemType:   itemType,
+		TargetItemId:     itemId,
+		RelationshipType: models.RelationshipGeneratedFrom,
+		ProjectId:        data.Options.ProjectId,
+	}
+}
+
+// parseTaigaDate parses the plain dates Taiga uses for due dates, e.g. 2024-05-31
+func parseTaigaDate(value string) (*time.Time, errors.Error) {
+	if value == "" {
+		return nil, nil
+	}
+	date, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		return nil, errors.Default.Wrap(err, "invalid date "+value)
+	}
+	return &date, nil
+}
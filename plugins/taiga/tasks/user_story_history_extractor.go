@@ -0,0 +1,147 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"encoding/json"
+
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/models/common"
+	"github.com/apache/incubator-devlake/core/plugin"
+	"github.com/apache/incubator-devlake/helpers/pluginhelper/api"
+	"github.com/irfanuddinahmad/taiga-devlake-plugin/plugins/taiga/models"
+)
+
+var _ plugin.SubTaskEntryPoint = ExtractUserStoryHistories
+
+var ExtractUserStoryHistoriesMeta = plugin.SubTaskMeta{
+	Name:             "extractUserStoryHistories",
+	EntryPoint:       ExtractUserStoryHistories,
+	EnabledByDefault: true,
+	Description:      "extract Taiga user story histories",
+	DomainTypes:      []string{models.ENTITY_TYPE_HISTORY, models.ENTITY_TYPE_COMMENT},
+	Dependencies:     []*plugin.SubTaskMeta{&CollectUserStoryHistoriesMeta},
+}
+
+// TaigaApiHistoryEntry is an entry of api/v1/history/{type}/{id}
+type TaigaApiHistoryEntry struct {
+	Id   string `json:"id"`
+	User struct {
+		Pk       uint64 `json:"pk"`
+		Username string `json:"username"`
+		Name     string `json:"name"`
+	} `json:"user"`
+	CreatedAt         *common.Iso8601Time `json:"created_at"`
+	Type              int                 `json:"type"`
+	Comment           string              `json:"comment"`
+	EditCommentDate   *common.Iso8601Time `json:"edit_comment_date"`
+	DeleteCommentDate *common.Iso8601Time `json:"delete_comment_date"`
+	// field name to a pair of old and new value
+	ValuesDiff map[string]json.RawMessage `json:"values_diff"`
+}
+
+func ExtractUserStoryHistories(taskCtx plugin.SubTaskContext) errors.Error {
+	data := taskCtx.GetData().(*TaigaTaskData)
+	extractor, err := api.NewApiExtractor(api.ApiExtractorArgs{
+		RawDataSubTaskArgs: api.RawDataSubTaskArgs{
+			Ctx: taskCtx,
+			Params: TaigaApiParams{
+				ConnectionId: data.Options.ConnectionId,
+				ProjectId:    data.Options.ProjectId,
+			},
+			Table: RAW_USER_STORY_HISTORY_TABLE,
+		},
+		Extract: func(row *api.RawData) ([]interface{}, errors.Error) {
+			var input SimpleUserStory
+			err := json.Unmarshal(row.Input, &input)
+			if err != nil {
+				return nil, errors.Default.Wrap(err, "error unmarshalling user story history input")
+			}
+			var entry TaigaApiHistoryEntry
+			err = json.Unmarshal(row.Data, &entry)
+			if err != nil {
+				return nil, errors.Default.Wrap(err, "error unmarshalling user story history")
+			}
+			if beforeTimeAfter(data, entry.CreatedAt.ToNullableTime()) {
+				return nil, nil
+			}
+
+			var results []interface{}
+			if comment := extractComment(&entry, models.ItemTypeUserStory, input.UserStoryId, data); comment != nil {
+				results = append(results, comment)
+			}
+			for _, changelog := range extractChangelogs(&entry, models.ItemTypeUserStory, input.UserStoryId, data) {
+				results = append(results, changelog)
+			}
+
+			return results, nil
+		},
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return extractor.Execute()
+}
+
+// extractComment returns the comment of a history entry, or nil when the entry only records changes
+func extractComment(entry *TaigaApiHistoryEntry, itemType string, itemId uint64, data *TaigaTaskData) *models.TaigaIssueComment {
+	if entry.Comment == "" && entry.DeleteCommentDate == nil {
+		return nil
+	}
+	return &models.TaigaIssueComment{
+		ConnectionId: data.Options.ConnectionId,
+		CommentId:    entry.Id,
+		ItemType:     itemType,
+		ItemId:       itemId,
+		ProjectId:    data.Options.ProjectId,
+		AuthorId:     entry.User.Pk,
+		AuthorName:   entry.User.Name,
+		Body:         entry.Comment,
+		CreatedDate:  entry.CreatedAt.ToNullableTime(),
+		EditedDate:   entry.EditCommentDate.ToNullableTime(),
+		DeletedDate:  entry.DeleteCommentDate.ToNullableTime(),
+	}
+}
+
+// extractChangelogs returns one changelog per field changed in a history entry, fields whose diff
+// is not a pair of old and new value, like description_diff, are skipped
+func extractChangelogs(entry *TaigaApiHistoryEntry, itemType string, itemId uint64, data *TaigaTaskData) []*models.TaigaIssueChangelog {
+	changelogs := make([]*models.TaigaIssueChangelog, 0, len(entry.ValuesDiff))
+	for field, diff := range entry.ValuesDiff {
+		var values []json.RawMessage
+		if json.Unmarshal(diff, &values) != nil || len(values) != 2 {
+			continue
+		}
+		changelogs = append(changelogs, &models.TaigaIssueChangelog{
+			ConnectionId: data.Options.ConnectionId,
+			ChangelogId:  entry.Id,
+			FieldName:    field,
+			ItemType:     itemType,
+			ItemId:       itemId,
+			ProjectId:    data.Options.ProjectId,
+			AuthorId:     entry.User.Pk,
+			AuthorName:   entry.User.Name,
+			FromValue:    rawValueToString(values[0]),
+			ToValue:      rawValueToString(values[1]),
+			CreatedDate:  entry.CreatedAt.ToNullableTime(),
+		})
+	}
+	return changelogs
+}
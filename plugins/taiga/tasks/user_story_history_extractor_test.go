@@ -0,0 +1,132 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/irfanuddinahmad/taiga-devlake-plugin/plugins/taiga/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func parseHistoryEntry(t *testing.T, raw string) *TaigaApiHistoryEntry {
+	var entry TaigaApiHistoryEntry
+	require.NoError(t, json.Unmarshal([]byte(raw), &entry))
+	return &entry
+}
+
+func timePtr(value string) *time.Time {
+	parsed, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		panic(err)
+	}
+	return &parsed
+}
+
+func TestExtractComment(t *testing.T) {
+	data := &TaigaTaskData{Options: &TaigaOptions{ConnectionId: 1, ProjectId: 2}}
+	tests := []struct {
+		name  string
+		entry string
+		want  *models.TaigaIssueComment
+	}{
+		{"change without comment", `{"id": "h1", "user": {"pk": 5, "name": "Ada"}, "created_at": "2024-01-07T09:00:00Z", "comment": "", "values_diff": {"status": ["New", "Done"]}}`, nil},
+		{"comment", `{"id": "h2", "user": {"pk": 5, "name": "Ada"}, "created_at": "2024-01-07T09:00:00Z", "comment": "Looks good"}`, &models.TaigaIssueComment{
+			ConnectionId: 1, CommentId: "h2", ItemType: models.ItemTypeTask, ItemId: 9, ProjectId: 2,
+			AuthorId: 5, AuthorName: "Ada", Body: "Looks good", CreatedDate: timePtr("2024-01-07T09:00:00Z"),
+		}},
+		{"edited comment", `{"id": "h3", "user": {"pk": 5, "name": "Ada"}, "created_at": "2024-01-07T09:00:00Z", "comment": "Fixed", "edit_comment_date": "2024-01-08T10:00:00Z"}`, &models.TaigaIssueComment{
+			ConnectionId: 1, CommentId: "h3", ItemType: models.ItemTypeTask, ItemId: 9, ProjectId: 2,
+			AuthorId: 5, AuthorName: "Ada", Body: "Fixed", CreatedDate: timePtr("2024-01-07T09:00:00Z"),
+			EditedDate: timePtr("2024-01-08T10:00:00Z"),
+		}},
+		{"deleted comment keeps no body", `{"id": "h4", "user": {"pk": 5, "name": "Ada"}, "created_at": "2024-01-07T09:00:00Z", "comment": "", "delete_comment_date": "2024-01-09T10:00:00Z"}`, &models.TaigaIssueComment{
+			ConnectionId: 1, CommentId: "h4", ItemType: models.ItemTypeTask, ItemId: 9, ProjectId: 2,
+			AuthorId: 5, AuthorName: "Ada", CreatedDate: timePtr("2024-01-07T09:00:00Z"),
+			DeletedDate: timePtr("2024-01-09T10:00:00Z"),
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := extractComment(parseHistoryEntry(t, tt.entry), models.ItemTypeTask, 9, data)
+			if tt.want == nil {
+				assert.Nil(t, got)
+				return
+			}
+			require.NotNil(t, got)
+			assert.Equal(t, tt.want.CommentId, got.CommentId)
+			assert.Equal(t, tt.want.ItemType, got.ItemType)
+			assert.Equal(t, tt.want.ItemId, got.ItemId)
+			assert.Equal(t, tt.want.ProjectId, got.ProjectId)
+			assert.Equal(t, tt.want.AuthorId, got.AuthorId)
+			assert.Equal(t, tt.want.AuthorName, got.AuthorName)
+			assert.Equal(t, tt.want.Body, got.Body)
+			assertSameTime(t, tt.want.CreatedDate, got.CreatedDate)
+			assertSameTime(t, tt.want.EditedDate, got.EditedDate)
+			assertSameTime(t, tt.want.DeletedDate, got.DeletedDate)
+		})
+	}
+}
+
+func assertSameTime(t *testing.T, want *time.Time, got *time.Time) {
+	if want == nil {
+		assert.Nil(t, got)
+		return
+	}
+	require.NotNil(t, got)
+	assert.True(t, want.Equal(*got), "expected %s, got %s", want, got)
+}
+
+func TestExtractChangelogs(t *testing.T) {
+	data := &TaigaTaskData{Options: &TaigaOptions{ConnectionId: 1, ProjectId: 2}}
+	entry := parseHistoryEntry(t, `{
+		"id": "h5",
+		"user": {"pk": 5, "name": "Ada"},
+		"created_at": "2024-01-07T09:00:00Z",
+		"values_diff": {
+			"status": ["New", "In progress"],
+			"is_blocked": [false, true],
+			"assigned_to": [null, 7],
+			"description_diff": "<p>changed</p>",
+			"tags": [["a"], ["a", "b"], ["c"]]
+		}
+	}`)
+	changelogs := extractChangelogs(entry, models.ItemTypeUserStory, 9, data)
+
+	got := make(map[string][2]string, len(changelogs))
+	for _, changelog := range changelogs {
+		assert.Equal(t, "h5", changelog.ChangelogId)
+		assert.Equal(t, models.ItemTypeUserStory, changelog.ItemType)
+		assert.Equal(t, uint64(9), changelog.ItemId)
+		assert.Equal(t, uint64(2), changelog.ProjectId)
+		assert.Equal(t, uint64(5), changelog.AuthorId)
+		assertSameTime(t, timePtr("2024-01-07T09:00:00Z"), changelog.CreatedDate)
+		got[changelog.FieldName] = [2]string{changelog.FromValue, changelog.ToValue}
+	}
+	// diffs that are not a pair of old and new value are skipped
+	assert.Equal(t, map[string][2]string{
+		"status":      {"New", "In progress"},
+		"is_blocked":  {"false", "true"},
+		"assigned_to": {"", "7"},
+	}, got)
+
+	assert.Empty(t, extractChangelogs(parseHistoryEntry(t, `{"id": "h6", "comment": "only a comment"}`), models.ItemTypeTask, 9, data))
+}
@@ -0,0 +1,86 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"encoding/json"
+
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/plugin"
+	"github.com/apache/incubator-devlake/helpers/pluginhelper/api"
+	"github.com/irfanuddinahmad/taiga-devlake-plugin/plugins/taiga/models"
+)
+
+var _ plugin.SubTaskEntryPoint = ExtractUserStoryVoters
+
+var ExtractUserStoryVotersMeta = plugin.SubTaskMeta{
+	Name:             "extractUserStoryVoters",
+	EntryPoint:       ExtractUserStoryVoters,
+	EnabledByDefault: true,
+	Description:      "extract voters of Taiga user stories",
+	DomainTypes:      []string{plugin.DOMAIN_TYPE_TICKET},
+	Dependencies:     []*plugin.SubTaskMeta{&CollectUserStoryVotersMeta},
+}
+
+func ExtractUserStoryVoters(taskCtx plugin.SubTaskContext) errors.Error {
+	data := taskCtx.GetData().(*TaigaTaskData)
+	extractor, err := api.NewApiExtractor(api.ApiExtractorArgs{
+		RawDataSubTaskArgs: api.RawDataSubTaskArgs{
+			Ctx: taskCtx,
+			Params: TaigaApiParams{
+				ConnectionId: data.Options.ConnectionId,
+				ProjectId:    data.Options.ProjectId,
+			},
+			Table: RAW_USER_STORY_VOTER_TABLE,
+		},
+		Extract: func(row *api.RawData) ([]interface{}, errors.Error) {
+			var input SimpleUserStory
+			err := json.Unmarshal(row.Input, &input)
+			if err != nil {
+				return nil, errors.Default.Wrap(err, "error unmarshalling user story voter input")
+			}
+			var apiVoter struct {
+				Id       uint64 `json:"id"`
+				Username string `json:"username"`
+				FullName string `json:"full_name"`
+			}
+			err = json.Unmarshal(row.Data, &apiVoter)
+			if err != nil {
+				return nil, errors.Default.Wrap(err, "error unmarshalling user story voter")
+			}
+
+			voter := &models.TaigaItemVoter{
+				ConnectionId: data.Options.ConnectionId,
+				ItemType:     models.ItemTypeUserStory,
+				ItemId:       input.UserStoryId,
+				AccountId:    apiVoter.Id,
+				ProjectId:    data.Options.ProjectId,
+				Username:     apiVoter.Username,
+				FullName:     apiVoter.FullName,
+			}
+
+			return []interface{}{voter}, nil
+		},
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return extractor.Execute()
+}
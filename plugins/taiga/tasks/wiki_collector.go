@@ -0,0 +1,100 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/plugin"
+	"github.com/apache/incubator-devlake/helpers/pluginhelper/api"
+	"github.com/irfanuddinahmad/taiga-devlake-plugin/plugins/taiga/models"
+)
+
+const (
+	RAW_WIKI_PAGE_TABLE = "taiga_api_wiki_pages"
+	RAW_WIKI_LINK_TABLE = "taiga_api_wiki_links"
+)
+
+var _ plugin.SubTaskEntryPoint = CollectWikiPages
+
+var CollectWikiPagesMeta = plugin.SubTaskMeta{
+	Name:             "collectWikiPages",
+	EntryPoint:       skipOnReplay(CollectWikiPages),
+	EnabledByDefault: true,
+	Description:      "collect Taiga wiki pages of the project",
+	DomainTypes:      []string{models.ENTITY_TYPE_WIKI},
+}
+
+var CollectWikiLinksMeta = plugin.SubTaskMeta{
+	Name:             "collectWikiLinks",
+	EntryPoint:       skipOnReplay(CollectWikiLinks),
+	EnabledByDefault: true,
+	Description:      "collect Taiga wiki links of the project",
+	DomainTypes:      []string{models.ENTITY_TYPE_WIKI},
+}
+
+func CollectWikiPages(taskCtx plugin.SubTaskContext) errors.Error {
+	return collectProjectList(taskCtx, RAW_WIKI_PAGE_TABLE, "wiki")
+}
+
+func CollectWikiLinks(taskCtx plugin.SubTaskContext) errors.Error {
+	return collectProjectList(taskCtx, RAW_WIKI_LINK_TABLE, "wiki-links")
+}
+
+// collectProjectList collects an unpaginated list resource filtered by project
+func collectProjectList(taskCtx plugin.SubTaskContext, table string, resource string) errors.Error {
+	data := taskCtx.GetData().(*TaigaTaskData)
+	logger := taskCtx.GetLogger()
+	logger.Info("collect %s", resource)
+
+	collector, err := api.NewApiCollector(api.ApiCollectorArgs{
+		RawDataSubTaskArgs: api.RawDataSubTaskArgs{
+			Ctx: taskCtx,
+			Params: TaigaApiParams{
+				ConnectionId: data.Options.ConnectionId,
+				ProjectId:    data.Options.ProjectId,
+			},
+			Table: table,
+		},
+		ApiClient:   data.ApiClient,
+		UrlTemplate: resource,
+		Query: func(reqData *api.RequestData) (url.Values, errors.Error) {
+			query := url.Values{}
+			query.Set("project", fmt.Sprintf("%d", data.Options.ProjectId))
+			return query, nil
+		},
+		ResponseParser: func(res *http.Response) ([]json.RawMessage, errors.Error) {
+			var result []json.RawMessage
+			err := api.UnmarshalResponse(res, &result)
+			if err != nil {
+				return nil, err
+			}
+			return result, nil
+		},
+		AfterResponse: withThrottling(data, ignoreHTTPStatus404),
+	})
+	if err != nil {
+		logger.Error(err, "collect %s error", resource)
+		return err
+	}
+	return collector.Execute()
+}
@@ -0,0 +1,142 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"encoding/json"
+
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/models/common"
+	"github.com/apache/incubator-devlake/core/plugin"
+	"github.com/apache/incubator-devlake/helpers/pluginhelper/api"
+	"github.com/irfanuddinahmad/taiga-devlake-plugin/plugins/taiga/models"
+)
+
+var _ plugin.SubTaskEntryPoint = ExtractWikiPages
+
+var ExtractWikiPagesMeta = plugin.SubTaskMeta{
+	Name:             "extractWikiPages",
+	EntryPoint:       ExtractWikiPages,
+	EnabledByDefault: true,
+	Description:      "extract Taiga wiki pages",
+	DomainTypes:      []string{models.ENTITY_TYPE_WIKI},
+	Dependencies:     []*plugin.SubTaskMeta{&CollectWikiPagesMeta},
+}
+
+var ExtractWikiLinksMeta = plugin.SubTaskMeta{
+	Name:             "extractWikiLinks",
+	EntryPoint:       ExtractWikiLinks,
+	EnabledByDefault: true,
+	Description:      "extract Taiga wiki links",
+	DomainTypes:      []string{models.ENTITY_TYPE_WIKI},
+	Dependencies:     []*plugin.SubTaskMeta{&CollectWikiLinksMeta},
+}
+
+func ExtractWikiPages(taskCtx plugin.SubTaskContext) errors.Error {
+	data := taskCtx.GetData().(*TaigaTaskData)
+	extractor, err := api.NewApiExtractor(api.ApiExtractorArgs{
+		RawDataSubTaskArgs: api.RawDataSubTaskArgs{
+			Ctx: taskCtx,
+			Params: TaigaApiParams{
+				ConnectionId: data.Options.ConnectionId,
+				ProjectId:    data.Options.ProjectId,
+			},
+			Table: RAW_WIKI_PAGE_TABLE,
+		},
+		Extract: func(row *api.RawData) ([]interface{}, errors.Error) {
+			var apiPage struct {
+				Id           uint64              `json:"id"`
+				Slug         string              `json:"slug"`
+				Version      int                 `json:"version"`
+				Editions     int                 `json:"editions"`
+				Owner        uint64              `json:"owner"`
+				LastModifier uint64              `json:"last_modifier"`
+				CreatedDate  *common.Iso8601Time `json:"created_date"`
+				ModifiedDate *common.Iso8601Time `json:"modified_date"`
+			}
+			err := json.Unmarshal(row.Data, &apiPage)
+			if err != nil {
+				return nil, errors.Default.Wrap(err, "error unmarshalling wiki page")
+			}
+
+			page := &models.TaigaWikiPage{
+				ConnectionId:   data.Options.ConnectionId,
+				WikiPageId:     apiPage.Id,
+				ProjectId:      data.Options.ProjectId,
+				Slug:           apiPage.Slug,
+				Version:        apiPage.Version,
+				Editions:       apiPage.Editions,
+				OwnerId:        apiPage.Owner,
+				LastModifierId: apiPage.LastModifier,
+				CreatedDate:    apiPage.CreatedDate.ToNullableTime(),
+				ModifiedDate:   apiPage.ModifiedDate.ToNullableTime(),
+			}
+
+			return []interface{}{page}, nil
+		},
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return extractor.Execute()
+}
+
+func ExtractWikiLinks(taskCtx plugin.SubTaskContext) errors.Error {
+	data := taskCtx.GetData().(*TaigaTaskData)
+	extractor, err := api.NewApiExtractor(api.ApiExtractorArgs{
+		RawDataSubTaskArgs: api.RawDataSubTaskArgs{
+			Ctx: taskCtx,
+			Params: TaigaApiParams{
+				ConnectionId: data.Options.ConnectionId,
+				ProjectId:    data.Options.ProjectId,
+			},
+			Table: RAW_WIKI_LINK_TABLE,
+		},
+		Extract: func(row *api.RawData) ([]interface{}, errors.Error) {
+			var apiLink struct {
+				Id    uint64 `json:"id"`
+				Title string `json:"title"`
+				Href  string `json:"href"`
+				Order int64  `json:"order"`
+			}
+			err := json.Unmarshal(row.Data, &apiLink)
+			if err != nil {
+				return nil, errors.Default.Wrap(err, "error unmarshalling wiki link")
+			}
+
+			link := &models.TaigaWikiLink{
+				ConnectionId: data.Options.ConnectionId,
+				WikiLinkId:   apiLink.Id,
+				ProjectId:    data.Options.ProjectId,
+				Title:        apiLink.Title,
+				Href:         apiLink.Href,
+				Order:        apiLink.Order,
+			}
+
+			return []interface{}{link}, nil
+		},
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return extractor.Execute()
+}
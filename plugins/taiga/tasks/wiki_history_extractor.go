@@ -0,0 +1,102 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"encoding/json"
+
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/plugin"
+	"github.com/apache/incubator-devlake/helpers/pluginhelper/api"
+	"github.com/irfanuddinahmad/taiga-devlake-plugin/plugins/taiga/models"
+)
+
+var _ plugin.SubTaskEntryPoint = ExtractWikiHistories
+
+var ExtractWikiHistoriesMeta = plugin.SubTaskMeta{
+	Name:             "extractWikiHistories",
+	EntryPoint:       ExtractWikiHistories,
+	EnabledByDefault: true,
+	Description:      "extract the edits of Taiga wiki pages",
+	DomainTypes:      []string{models.ENTITY_TYPE_WIKI},
+	Dependencies:     []*plugin.SubTaskMeta{&CollectWikiHistoriesMeta},
+}
+
+// types of history entries
+const (
+	historyTypeChange = 1
+	historyTypeCreate = 2
+)
+
+func ExtractWikiHistories(taskCtx plugin.SubTaskContext) errors.Error {
+	data := taskCtx.GetData().(*TaigaTaskData)
+	extractor, err := api.NewApiExtractor(api.ApiExtractorArgs{
+		RawDataSubTaskArgs: api.RawDataSubTaskArgs{
+			Ctx: taskCtx,
+			Params: TaigaApiParams{
+				ConnectionId: data.Options.ConnectionId,
+				ProjectId:    data.Options.ProjectId,
+			},
+			Table: RAW_WIKI_HISTORY_TABLE,
+		},
+		Extract: func(row *api.RawData) ([]interface{}, errors.Error) {
+			var input SimpleWikiPage
+			err := json.Unmarshal(row.Input, &input)
+			if err != nil {
+				return nil, errors.Default.Wrap(err, "error unmarshalling wiki history input")
+			}
+			var entry TaigaApiHistoryEntry
+			err = json.Unmarshal(row.Data, &entry)
+			if err != nil {
+				return nil, errors.Default.Wrap(err, "error unmarshalling wiki history")
+			}
+			if beforeTimeAfter(data, entry.CreatedAt.ToNullableTime()) {
+				return nil, nil
+			}
+
+			var editType string
+			switch entry.Type {
+			case historyTypeCreate:
+				editType = models.DocumentationActivityCreated
+			case historyTypeChange:
+				editType = models.DocumentationActivityEdited
+			default:
+				return nil, nil
+			}
+
+			edit := &models.TaigaWikiPageEdit{
+				ConnectionId: data.Options.ConnectionId,
+				EditId:       entry.Id,
+				WikiPageId:   input.WikiPageId,
+				ProjectId:    data.Options.ProjectId,
+				AuthorId:     entry.User.Pk,
+				AuthorName:   entry.User.Name,
+				EditType:     editType,
+				CreatedDate:  entry.CreatedAt.ToNullableTime(),
+			}
+
+			return []interface{}{edit}, nil
+		},
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return extractor.Execute()
+}